@@ -0,0 +1,130 @@
+package option
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// Number is a constraint for types which support arithmetic
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+func isFloat[T Number]() bool {
+	var half T = 1
+	half /= 2
+	return half != 0
+}
+
+// Sum returns the sum of Some values, None if there are none.
+// Floats are summed with Neumaier compensated summation to limit rounding errors
+func Sum[T Number](opts Options[T]) Option[T] {
+	if opts.CountSome() == 0 {
+		return O[T]()
+	}
+	if !isFloat[T]() {
+		return O(Foldl(opts, func(sum T, next T) T { return sum + next }, 0))
+	}
+	var sum, c T
+	Each(opts, func(x T) {
+		t := sum + x
+		if math.IsInf(float64(t), 0) {
+			// the compensation of an infinite sum would be Inf - Inf = NaN
+			sum = t
+			return
+		}
+		if abs(sum) >= abs(x) {
+			c += (sum - t) + x
+		} else {
+			c += (x - t) + sum
+		}
+		sum = t
+	})
+	if math.IsInf(float64(sum), 0) {
+		return O(sum)
+	}
+	return O(sum + c)
+}
+
+func abs[T Number](x T) T {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Min returns the smallest Some value, None if there are none
+func Min[T cmp.Ordered](opts Options[T]) Option[T] {
+	return MinBy(opts, cmp.Compare[T])
+}
+
+// Max returns the largest Some value, None if there are none
+func Max[T cmp.Ordered](opts Options[T]) Option[T] {
+	return MaxBy(opts, cmp.Compare[T])
+}
+
+// MinBy returns the first smallest Some value according to the provided comparison, None if there are none
+func MinBy[T any](opts Options[T], fn func(a, b T) int) Option[T] {
+	return Foldl(opts, func(res Option[T], next T) Option[T] {
+		if res.IsNone() || fn(next, *res.some) < 0 {
+			return O(next)
+		}
+		return res
+	}, O[T]())
+}
+
+// MaxBy returns the first largest Some value according to the provided comparison, None if there are none
+func MaxBy[T any](opts Options[T], fn func(a, b T) int) Option[T] {
+	return Foldl(opts, func(res Option[T], next T) Option[T] {
+		if res.IsNone() || fn(next, *res.some) > 0 {
+			return O(next)
+		}
+		return res
+	}, O[T]())
+}
+
+// Mean returns the arithmetic mean of Some values, None if there are none.
+// It is computed incrementally so that large integer sums do not overflow
+func Mean[T Number](opts Options[T]) Option[float64] {
+	n := 0
+	mean := Foldl(opts, func(mean float64, next T) float64 {
+		n++
+		if math.IsInf(mean, 0) {
+			// only an infinity of the opposite sign changes an infinite mean, to NaN
+			return mean + float64(next)
+		}
+		// dividing before subtracting keeps values close to the float64 limits finite
+		return mean + (float64(next)/float64(n) - mean/float64(n))
+	}, 0)
+	if n == 0 {
+		return O[float64]()
+	}
+	return O(mean)
+}
+
+// Median returns the median of Some values, None if there are none
+func Median[T Number](opts Options[T]) Option[float64] {
+	return Percentile(opts, 50)
+}
+
+// Percentile returns the p-th percentile of Some values using linear interpolation between
+// the closest ranks, None if there are no Some values or p is not within [0, 100]
+func Percentile[T Number](opts Options[T], p float64) Option[float64] {
+	if !(p >= 0 && p <= 100) {
+		return O[float64]()
+	}
+	values := Map(opts, func(some T) float64 { return float64(some) })
+	if len(values) == 0 {
+		return O[float64]()
+	}
+	slices.Sort(values)
+	rank := p / 100 * float64(len(values)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	frac := rank - float64(lo)
+	// a convex combination does not overflow for values close to the float64 limits
+	return O(values[lo]*(1-frac) + values[hi]*frac)
+}
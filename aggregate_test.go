@@ -0,0 +1,168 @@
+package option_test
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"testing"
+	"testing/quick"
+
+	"github.com/debudda/option"
+)
+
+var metrics = option.Options[float64]{option.O(3.0), option.O[float64](), option.O(1.0), option.O(4.0), option.O(2.0)}
+
+func ExampleSum() {
+	fmt.Println(option.Sum(metrics), option.Sum(option.Options[int]{option.O[int]()}))
+	// Output: Some(10) None
+}
+
+func ExampleMin() {
+	fmt.Println(option.Min(metrics), option.Max(metrics))
+	// Output: Some(1) Some(4)
+}
+
+func ExampleMinBy() {
+	byAge := func(a, b Writer) int { return a.Age - b.Age }
+	fmt.Println(option.MinBy(options, byAge).Default(Writer{}).Name)
+	fmt.Println(option.MaxBy(options, byAge).Default(Writer{}).Name)
+	// Output: Douglas Adams
+	// Neal Stephenson
+}
+
+func ExampleMean() {
+	fmt.Println(option.Mean(metrics), option.Median(metrics))
+	fmt.Println(option.Percentile(metrics, 90), option.Percentile(metrics, 101))
+	// Output: Some(2.5) Some(2.5)
+	// Some(3.7) None
+}
+
+func ExampleSum_stable() {
+	opts := option.Options[float64]{option.O(1.0), option.O(1e100), option.O(1.0), option.O(-1e100)}
+	fmt.Println(option.Sum(opts))
+	// Output: Some(2)
+}
+
+// sparse turns generated values into Options where every value divisible by 3 is None
+func sparse[T int8 | int32 | float64](xs []T) (opts option.Options[T], some []T) {
+	for i, x := range xs {
+		if i%3 == 0 {
+			opts = append(opts, option.O[T]())
+			continue
+		}
+		opts = append(opts, option.O(x))
+		some = append(some, x)
+	}
+	return
+}
+
+const eps = 0x1p-52
+
+func TestAggregate_properties(t *testing.T) {
+	props := map[string]any{
+		"sum of ints": func(xs []int32) bool {
+			opts, some := sparse(xs)
+			var want int32
+			for _, x := range some {
+				want += x
+			}
+			return option.Sum(opts).IsNone() == (len(some) == 0) && option.Sum(opts).Default(0) == want
+		},
+		"min and max bound every value": func(xs []int8) bool {
+			opts, some := sparse(xs)
+			lo, hi := option.Min(opts), option.Max(opts)
+			if len(some) == 0 {
+				return lo.IsNone() && hi.IsNone()
+			}
+			return lo.Default(0) == slices.Min(some) && hi.Default(0) == slices.Max(some)
+		},
+		"mean is between min and max": func(xs []int32) bool {
+			opts, some := sparse(xs)
+			mean := option.Mean(opts)
+			if len(some) == 0 {
+				return mean.IsNone()
+			}
+			m := mean.Default(math.NaN())
+			return m >= float64(slices.Min(some))-1e-6 && m <= float64(slices.Max(some))+1e-6
+		},
+		"mean of floats is between min and max": meanBounded,
+		"percentiles are monotonic": func(xs []float64, p1, p2 uint8) bool {
+			opts, some := sparse(xs)
+			a, b := float64(p1%101), float64(p2%101)
+			if a > b {
+				a, b = b, a
+			}
+			pa, pb := option.Percentile(opts, a), option.Percentile(opts, b)
+			if len(some) == 0 {
+				return pa.IsNone() && pb.IsNone()
+			}
+			return pa.Default(0) <= pb.Default(0) &&
+				option.Percentile(opts, 0).Default(0) == slices.Min(some) &&
+				option.Percentile(opts, 100).Default(0) == slices.Max(some)
+		},
+		"compensated sum is close to the exact sum": func(xs []int32) bool {
+			fs := make([]float64, len(xs))
+			for i, x := range xs {
+				fs[i] = float64(x) / 7
+			}
+			opts, some := sparse(fs)
+			exact, abs := new(big.Float).SetPrec(2048), 0.0
+			for _, x := range some {
+				exact.Add(exact, big.NewFloat(x))
+				abs += math.Abs(x)
+			}
+			want, _ := exact.Float64()
+			got := option.Sum(opts).Default(0)
+			return math.Abs(got-want) <= 2*eps*math.Abs(want)+float64(len(some))*eps*eps*abs
+		},
+	}
+	for name, prop := range props {
+		t.Run(name, func(t *testing.T) {
+			if err := quick.Check(prop, nil); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+	// quick never generates values at the float64 limits
+	for _, xs := range [][]float64{
+		{math.MaxFloat64, math.MaxFloat64, math.MaxFloat64},
+		{math.MaxFloat64, -math.MaxFloat64, math.MaxFloat64},
+		{-math.MaxFloat64, -math.MaxFloat64},
+		{math.Inf(1), 1},
+		{1, math.Inf(-1)},
+	} {
+		if !meanBounded(append([]float64{0}, xs...)) {
+			t.Errorf("mean of %v is %v", xs, option.Mean(option.Slice(xs...)))
+		}
+	}
+}
+
+// meanBounded reports whether the Mean of the values which are not made None by sparse is between their min and max
+func meanBounded(xs []float64) bool {
+	opts, some := sparse(xs)
+	mean := option.Mean(opts)
+	if len(some) == 0 {
+		return mean.IsNone()
+	}
+	m := mean.Default(math.NaN())
+	lo, hi := slices.Min(some), slices.Max(some)
+	return m >= lo-math.Abs(lo)*1e-12 && m <= hi+math.Abs(hi)*1e-12
+}
+
+func TestSum_infinite(t *testing.T) {
+	for _, c := range []struct {
+		xs   []float64
+		want float64
+	}{
+		{[]float64{math.Inf(1)}, math.Inf(1)},
+		{[]float64{1, math.Inf(-1), 2}, math.Inf(-1)},
+		{[]float64{math.MaxFloat64, math.MaxFloat64}, math.Inf(1)},
+		{[]float64{-math.MaxFloat64, -math.MaxFloat64, 1}, math.Inf(-1)},
+		{[]float64{math.MaxFloat64, -math.MaxFloat64, 1}, 1},
+	} {
+		if got := option.Sum(option.Slice(c.xs...)); !option.Contains(got, c.want) {
+			t.Errorf("Sum(%v) = %v, want %v", c.xs, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package option
+
+import (
+	"iter"
+	"slices"
+)
+
+// Values returns an iterator over Some values
+func (opts Options[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, opt := range opts {
+			if opt.IsSome() && !yield(*opt.some) {
+				return
+			}
+		}
+	}
+}
+
+// ChunkSeq returns an iterator over consecutive chunks of n Some values, the last chunk may be shorter.
+// None values are skipped, it panics if n is less than 1
+func ChunkSeq[T any](opts Options[T], n int) iter.Seq[[]T] {
+	if n < 1 {
+		panic("option: chunk size must be at least 1")
+	}
+	return BatchBySeq(opts, func(T) int { return 1 }, n)
+}
+
+// Chunk splits Some values into consecutive chunks of n values, the last chunk may be shorter.
+// None values are skipped, it panics if n is less than 1
+func Chunk[T any](opts Options[T], n int) [][]T {
+	return slices.Collect(ChunkSeq(opts, n))
+}
+
+// WindowSeq returns an iterator over sliding windows of size Some values moving by step values,
+// only full windows are produced. None values are skipped, it panics if size or step is less than 1
+func WindowSeq[T any](opts Options[T], size, step int) iter.Seq[[]T] {
+	if size < 1 || step < 1 {
+		panic("option: window size and step must be at least 1")
+	}
+	return func(yield func([]T) bool) {
+		values := opts.Filter(func(T) bool { return true })
+		for i := 0; i+size <= len(values); i += step {
+			if !yield(slices.Clone(values[i : i+size])) {
+				return
+			}
+		}
+	}
+}
+
+// Window splits Some values into sliding windows of size values moving by step values,
+// only full windows are produced. None values are skipped, it panics if size or step is less than 1
+func Window[T any](opts Options[T], size, step int) [][]T {
+	return slices.Collect(WindowSeq(opts, size, step))
+}
+
+// BatchBySeq returns an iterator over consecutive batches of Some values whose total weight does not exceed maxWeight,
+// a value heavier than maxWeight is put into a batch of its own. None values are skipped
+func BatchBySeq[T any](opts Options[T], weight func(some T) int, maxWeight int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		var (
+			batch []T
+			total int
+		)
+		for t := range opts.Values() {
+			w := weight(t)
+			if len(batch) > 0 && total+w > maxWeight {
+				if !yield(batch) {
+					return
+				}
+				batch, total = nil, 0
+			}
+			batch = append(batch, t)
+			total += w
+		}
+		if len(batch) > 0 {
+			yield(batch)
+		}
+	}
+}
+
+// BatchBy splits Some values into consecutive batches whose total weight does not exceed maxWeight,
+// a value heavier than maxWeight is put into a batch of its own. None values are skipped
+func BatchBy[T any](opts Options[T], weight func(some T) int, maxWeight int) [][]T {
+	return slices.Collect(BatchBySeq(opts, weight, maxWeight))
+}
+
+// FlattenSeq returns an iterator over the elements of Some slices, None values are skipped
+func FlattenSeq[T any](opts Options[[]T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for ts := range opts.Values() {
+			for _, t := range ts {
+				if !yield(t) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Flatten concatenates Some slices into Options, None values are skipped
+func Flatten[T any](opts Options[[]T]) (res Options[T]) {
+	for t := range FlattenSeq(opts) {
+		res.Push(t)
+	}
+	return
+}
@@ -0,0 +1,61 @@
+package option_test
+
+import (
+	"fmt"
+
+	"github.com/debudda/option"
+)
+
+var rows = option.Options[string]{
+	option.O("a"), option.O("bb"), option.O[string](), option.O("ccc"), option.O("d"), option.O("eeeee"),
+}
+
+func ExampleChunk() {
+	fmt.Println(option.Chunk(rows, 2))
+	// Output: [[a bb] [ccc d] [eeeee]]
+}
+
+func ExampleChunkSeq() {
+	for chunk := range option.ChunkSeq(rows, 2) {
+		fmt.Println(chunk)
+		if len(chunk) > 0 && chunk[0] == "ccc" {
+			break
+		}
+	}
+	// Output: [a bb]
+	// [ccc d]
+}
+
+func ExampleWindow() {
+	fmt.Println(option.Window(rows, 3, 1))
+	fmt.Println(option.Window(rows, 2, 2))
+	// Output: [[a bb ccc] [bb ccc d] [ccc d eeeee]]
+	// [[a bb] [ccc d]]
+}
+
+func ExampleBatchBy() {
+	size := func(s string) int { return len(s) }
+	fmt.Println(option.BatchBy(rows, size, 4))
+	// Output: [[a bb] [ccc d] [eeeee]]
+}
+
+func ExampleFlatten() {
+	batches := option.Options[[]int]{option.O([]int{1, 2}), option.O[[]int](), option.O([]int{3})}
+	fmt.Println(option.Flatten(batches))
+	for n := range option.FlattenSeq(batches) {
+		if n > 1 {
+			break
+		}
+		fmt.Println(n)
+	}
+	// Output: [Some(1) Some(2) Some(3)]
+	// 1
+}
+
+func ExampleOptions_Values() {
+	for s := range rows.Values() {
+		fmt.Print(s, " ")
+	}
+	fmt.Println()
+	// Output: a bb ccc d eeeee
+}
@@ -0,0 +1,186 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/debudda/option"
+)
+
+// Clock provides the current time, it can be replaced in tests
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Config configures a Cache, the zero value is an unbounded cache without expiration
+type Config struct {
+	// TTL is the lifetime of successfully loaded values, zero disables expiration
+	TTL time.Duration
+	// NegativeTTL is the lifetime of errors returned by GetOrLoad, zero disables caching of errors
+	NegativeTTL time.Duration
+	// MaxEntries limits the number of entries evicting the least recently used ones, zero means no limit
+	MaxEntries int
+	// Clock defaults to the system clock
+	Clock Clock
+}
+
+type entry[K comparable, V any] struct {
+	key     K
+	res     option.Result[V]
+	expires time.Time
+}
+
+type call[V any] struct {
+	done chan struct{}
+	res  option.Result[V]
+	// stale is set under the Cache lock when the key is changed during the load
+	stale bool
+}
+
+// Cache is a concurrency-safe expiring LRU cache of values and errors
+type Cache[K comparable, V any] struct {
+	cfg   Config
+	mu    sync.Mutex
+	items map[K]*list.Element
+	lru   *list.List
+	calls map[K]*call[V]
+}
+
+// New constructs a Cache
+func New[K comparable, V any](cfg Config) *Cache[K, V] {
+	if cfg.Clock == nil {
+		cfg.Clock = systemClock{}
+	}
+	return &Cache[K, V]{
+		cfg:   cfg,
+		items: map[K]*list.Element{},
+		lru:   list.New(),
+		calls: map[K]*call[V]{},
+	}
+}
+
+// Get returns the cached value under k, None if it is missing, expired or a cached error
+func (c *Cache[K, V]) Get(k K) (res option.Option[V]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lookup(k).Some(func(e *entry[K, V]) {
+		e.res.Ok(func(v V) {
+			res = option.O(v)
+		})
+	})
+	return
+}
+
+// Set stores v under k
+func (c *Cache[K, V]) Set(k K, v V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidate(k)
+	c.store(k, option.Ok(v), c.cfg.TTL)
+}
+
+// Delete removes k from the cache
+func (c *Cache[K, V]) Delete(k K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invalidate(k)
+	if el, ok := c.items[k]; ok {
+		c.remove(el)
+	}
+}
+
+// Len returns the number of entries including expired ones which have not been accessed yet
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// GetOrLoad returns the cached Result under k or calls load to produce it. Concurrent calls for the same key
+// share a single load, errors are cached for NegativeTTL. If load panics the waiting callers get an uninitialized Result.
+// A Set or Delete of k during the load wins, the loaded Result is returned to its callers but not cached
+func (c *Cache[K, V]) GetOrLoad(k K, load func() option.Result[V]) option.Result[V] {
+	c.mu.Lock()
+	if e := c.lookup(k); e.IsSome() {
+		c.mu.Unlock()
+		return e.Default(nil).res
+	}
+	if cl, ok := c.calls[k]; ok {
+		c.mu.Unlock()
+		<-cl.done
+		return cl.res
+	}
+	cl := &call[V]{done: make(chan struct{})}
+	c.calls[k] = cl
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		if c.calls[k] == cl {
+			delete(c.calls, k)
+		}
+		switch {
+		case cl.stale:
+		case cl.res.IsOk():
+			c.store(k, cl.res, c.cfg.TTL)
+		case cl.res.IsErr() && c.cfg.NegativeTTL > 0:
+			c.store(k, cl.res, c.cfg.NegativeTTL)
+		}
+		c.mu.Unlock()
+		close(cl.done)
+	}()
+	cl.res = load()
+	return cl.res
+}
+
+// invalidate detaches the load in flight for k so its Result is not stored,
+// later calls to GetOrLoad start a new load
+func (c *Cache[K, V]) invalidate(k K) {
+	if cl, ok := c.calls[k]; ok {
+		cl.stale = true
+		delete(c.calls, k)
+	}
+}
+
+// lookup returns a fresh entry marking it as recently used, expired entries are removed
+func (c *Cache[K, V]) lookup(k K) option.Option[*entry[K, V]] {
+	el, ok := c.items[k]
+	if !ok {
+		return option.O[*entry[K, V]]()
+	}
+	e := el.Value.(*entry[K, V])
+	if !e.expires.IsZero() && !c.cfg.Clock.Now().Before(e.expires) {
+		c.remove(el)
+		return option.O[*entry[K, V]]()
+	}
+	c.lru.MoveToFront(el)
+	return option.O(e)
+}
+
+func (c *Cache[K, V]) store(k K, res option.Result[V], ttl time.Duration) {
+	e := &entry[K, V]{key: k, res: res}
+	if ttl > 0 {
+		e.expires = c.cfg.Clock.Now().Add(ttl)
+	}
+	if el, ok := c.items[k]; ok {
+		el.Value = e
+		c.lru.MoveToFront(el)
+		return
+	}
+	c.items[k] = c.lru.PushFront(e)
+	if c.cfg.MaxEntries > 0 && c.lru.Len() > c.cfg.MaxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *Cache[K, V]) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.items, el.Value.(*entry[K, V]).key)
+}
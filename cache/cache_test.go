@@ -0,0 +1,179 @@
+package cache_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/debudda/option"
+	"github.com/debudda/option/cache"
+)
+
+// a clock faking option.RetryClock can drive a Cache as well
+var _ cache.Clock = option.RetryClock(nil)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func ExampleCache_GetOrLoad() {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	c := cache.New[string, int](cache.Config{TTL: time.Minute, Clock: clock})
+	load := func() option.Result[int] {
+		fmt.Println("loading")
+		return option.Ok(42)
+	}
+	fmt.Println(c.GetOrLoad("answer", load))
+	fmt.Println(c.GetOrLoad("answer", load))
+	clock.Advance(time.Minute)
+	fmt.Println(c.Get("answer"))
+	fmt.Println(c.GetOrLoad("answer", load))
+	// Output: loading
+	// Ok(42)
+	// Ok(42)
+	// None
+	// loading
+	// Ok(42)
+}
+
+func ExampleCache_negative() {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	c := cache.New[string, int](cache.Config{NegativeTTL: time.Second, Clock: clock})
+	calls := 0
+	load := func() option.Result[int] {
+		calls++
+		return option.Err[int](errors.New("upstream down"))
+	}
+	fmt.Println(c.GetOrLoad("k", load), c.GetOrLoad("k", load), c.Get("k"), calls)
+	clock.Advance(time.Second)
+	fmt.Println(c.GetOrLoad("k", load), calls)
+	// Output: Err(upstream down) Err(upstream down) None 1
+	// Err(upstream down) 2
+}
+
+func ExampleCache_lru() {
+	c := cache.New[string, int](cache.Config{MaxEntries: 2})
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Set("c", 3)
+	fmt.Println(c.Get("a"), c.Get("b"), c.Get("c"), c.Len())
+	c.Delete("a")
+	fmt.Println(c.Get("a"), c.Len())
+	// Output: Some(1) None Some(3) 2
+	// None 1
+}
+
+func TestCache_GetOrLoad_singleflight(t *testing.T) {
+	c := cache.New[int, int](cache.Config{})
+	var (
+		calls   atomic.Int64
+		wg      sync.WaitGroup
+		release = make(chan struct{})
+	)
+	for w := 0; w < 32; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res := c.GetOrLoad(1, func() option.Result[int] {
+				calls.Add(1)
+				<-release
+				return option.Ok(7)
+			})
+			if res.Default(0) != 7 {
+				t.Errorf("unexpected result %v", res)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Fatalf("expected a single load, got %d", calls.Load())
+	}
+}
+
+func TestCache_GetOrLoad_invalidated(t *testing.T) {
+	for _, c := range []struct {
+		name   string
+		change func(c *cache.Cache[int, int])
+		want   option.Option[int]
+	}{
+		{"delete", func(c *cache.Cache[int, int]) { c.Delete(1) }, option.O[int]()},
+		{"set", func(c *cache.Cache[int, int]) { c.Set(1, 2) }, option.O(2)},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			cc := cache.New[int, int](cache.Config{})
+			started, release := make(chan struct{}), make(chan struct{})
+			done := make(chan option.Result[int])
+			go func() {
+				done <- cc.GetOrLoad(1, func() option.Result[int] {
+					close(started)
+					<-release
+					return option.Ok(1)
+				})
+			}()
+			<-started
+			c.change(cc)
+			close(release)
+			if res := <-done; !option.ContainsOk(res, 1) {
+				t.Fatalf("expected the loader to get Ok(1), got %v", res)
+			}
+			if got := cc.Get(1); !option.Equal(got, c.want) {
+				t.Fatalf("expected %v to win over the load, got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestCache_concurrent(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	c := cache.New[int, int](cache.Config{TTL: time.Second, MaxEntries: 50, NegativeTTL: time.Second, Clock: clock})
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				k := (i * (w + 1)) % 100
+				switch i % 5 {
+				case 0:
+					c.Set(k, i)
+				case 1:
+					c.Get(k)
+				case 2:
+					c.Delete(k)
+				case 3:
+					clock.Advance(time.Millisecond)
+				default:
+					c.GetOrLoad(k, func() option.Result[int] {
+						if k%2 == 0 {
+							return option.Err[int](errors.New("odd"))
+						}
+						return option.Ok(k)
+					})
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if c.Len() > 50 {
+		t.Fatalf("expected at most 50 entries, got %d", c.Len())
+	}
+}
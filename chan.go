@@ -0,0 +1,84 @@
+package option
+
+import (
+	"context"
+	"sync"
+)
+
+// FromChan receives values until ch is closed and collects them into Options
+func FromChan[T any](ch <-chan T) (res Options[T]) {
+	for t := range ch {
+		res.Push(t)
+	}
+	return
+}
+
+// Recv blocks until a value is received, returns None if ch is closed
+func Recv[T any](ch <-chan T) Option[T] {
+	t, ok := <-ch
+	if !ok {
+		return O[T]()
+	}
+	return O(t)
+}
+
+// TryRecv receives a value without blocking, returns None if no value is ready or ch is closed
+func TryRecv[T any](ch <-chan T) Option[T] {
+	select {
+	case t, ok := <-ch:
+		if !ok {
+			return O[T]()
+		}
+		return O(t)
+	default:
+		return O[T]()
+	}
+}
+
+// SendSome sends the values of Some Options to ch skipping None, returns the number of sent values
+func SendSome[T any](ch chan<- T, opts ...Option[T]) int {
+	n := 0
+	for _, opt := range opts {
+		opt.Some(func(t T) {
+			ch <- t
+			n++
+		})
+	}
+	return n
+}
+
+// Pipe processes values from in with fn using the provided number of workers and sends the Results to the returned channel.
+// Results are not ordered, the channel is closed once in is closed and drained or ctx is cancelled
+func Pipe[T, R any](ctx context.Context, in <-chan T, workers int, fn func(ctx context.Context, t T) Result[R]) <-chan Result[R] {
+	if workers < 1 {
+		workers = 1
+	}
+	out := make(chan Result[R])
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case t, ok := <-in:
+					if !ok {
+						return
+					}
+					select {
+					case out <- fn(ctx, t):
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
@@ -0,0 +1,97 @@
+package option_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/debudda/option"
+)
+
+func ExampleFromChan() {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	close(ch)
+	fmt.Println(option.FromChan(ch))
+	// Output: [Some(1) Some(2)]
+}
+
+func ExampleRecv() {
+	ch := make(chan string, 1)
+	ch <- "hello"
+	close(ch)
+	fmt.Println(option.Recv(ch), option.Recv(ch))
+	// Output: Some(hello) None
+}
+
+func ExampleTryRecv() {
+	ch := make(chan int, 1)
+	fmt.Println(option.TryRecv(ch))
+	ch <- 1
+	fmt.Println(option.TryRecv(ch))
+	// Output: None
+	// Some(1)
+}
+
+func ExampleSendSome() {
+	ch := make(chan int, 3)
+	n := option.SendSome(ch, option.O(1), option.O[int](), option.O(3))
+	close(ch)
+	fmt.Println(n, option.FromChan(ch))
+	// Output: 2 [Some(1) Some(3)]
+}
+
+func ExamplePipe() {
+	in := make(chan string)
+	go func() {
+		defer close(in)
+		for _, s := range []string{"1", "2", "x", "4"} {
+			in <- s
+		}
+	}()
+	var (
+		sum  int
+		errs []string
+	)
+	for res := range option.Pipe(context.Background(), in, 3, func(ctx context.Context, s string) option.Result[int] {
+		return option.Try(strconv.Atoi(s))
+	}) {
+		res.Switch(
+			func(n int) { sum += n },
+			func(err error) { errs = append(errs, err.Error()) })
+	}
+	fmt.Println(sum, errs)
+	// Output: 7 [strconv.Atoi: parsing "x": invalid syntax]
+}
+
+func TestPipe_cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	in := make(chan int)
+	go func() {
+		for i := 0; ; i++ {
+			select {
+			case in <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	out := option.Pipe(ctx, in, 4, func(ctx context.Context, n int) option.Result[int] {
+		return option.Ok(n)
+	})
+	var got []int
+	for res := range out {
+		res.Ok(func(n int) { got = append(got, n) })
+		if len(got) == 10 {
+			cancel()
+		}
+	}
+	slices.Sort(got)
+	if len(got) < 10 || got[0] != 0 {
+		t.Fatalf("unexpected results %v", got)
+	}
+}
@@ -0,0 +1,156 @@
+package option
+
+import (
+	"cmp"
+	"errors"
+	"reflect"
+)
+
+// Key is a comparable representation of an Option which can be used as a map key
+type Key[T comparable] struct {
+	Value T
+	Some  bool
+}
+
+// Option converts the Key back to an Option
+func (k Key[T]) Option() Option[T] {
+	if !k.Some {
+		return O[T]()
+	}
+	return O(k.Value)
+}
+
+// KeyOf returns a comparable Key of the Option, equal Options produce equal Keys
+func KeyOf[T comparable](o Option[T]) Key[T] {
+	if o.IsNone() {
+		return Key[T]{}
+	}
+	return Key[T]{Value: *o.some, Some: true}
+}
+
+// ResultKey is a comparable representation of a Result which can be used as a map key, the stack trace
+// and fields of a TracedError are dropped, it panics when used as a key if the dynamic type of Err is not comparable
+type ResultKey[T comparable] struct {
+	Value T
+	Err   error
+}
+
+// Result converts the ResultKey back to a Result
+func (k ResultKey[T]) Result() Result[T] {
+	if k.Err != nil {
+		return Err[T](k.Err)
+	}
+	return Ok(k.Value)
+}
+
+// ResultKeyOf returns a comparable ResultKey of the Result
+func ResultKeyOf[T comparable](r Result[T]) ResultKey[T] {
+	if r.IsOk() {
+		return ResultKey[T]{Value: *r.t}
+	}
+	return ResultKey[T]{Err: untraced(r.err())}
+}
+
+// Equal reports whether both Options are None or both are Some with equal values
+func Equal[T comparable](a, b Option[T]) bool {
+	return EqualFunc(a, b, func(a, b T) bool { return a == b })
+}
+
+// EqualFunc reports whether both Options are None or both are Some with values equal according to eq
+func EqualFunc[T any](a, b Option[T], eq func(a, b T) bool) bool {
+	if a.IsNone() || b.IsNone() {
+		return a.IsNone() == b.IsNone()
+	}
+	return eq(*a.some, *b.some)
+}
+
+// Compare orders Options, None sorts before any Some, use NoneLast to change it,
+// returns a negative number when a < b, zero when a == b and a positive number when a > b
+func Compare[T cmp.Ordered](a, b Option[T]) int {
+	return CompareFunc(a, b, cmp.Compare[T])
+}
+
+// CompareFunc orders Options with the provided callback, None sorts before any Some
+func CompareFunc[T any](a, b Option[T], fn func(a, b T) int) int {
+	switch {
+	case a.IsNone() && b.IsNone():
+		return 0
+	case a.IsNone():
+		return -1
+	case b.IsNone():
+		return 1
+	}
+	return fn(*a.some, *b.some)
+}
+
+// NoneLast wraps a None first Option comparison such as Compare so that None sorts after any Some
+//
+//	slices.SortFunc(opts, option.NoneLast(option.Compare[int]))
+func NoneLast[T any](fn func(a, b Option[T]) int) func(a, b Option[T]) int {
+	return func(a, b Option[T]) int {
+		if a.IsNone() != b.IsNone() {
+			return -fn(a, b)
+		}
+		return fn(a, b)
+	}
+}
+
+// Contains reports whether the Option is Some and holds v
+func Contains[T comparable](o Option[T], v T) bool {
+	return o.IsSome() && *o.some == v
+}
+
+// EqualResult reports whether both Results are Ok with equal values or both are not Ok with the same error,
+// stack traces and fields of a TracedError are ignored
+func EqualResult[T comparable](a, b Result[T]) bool {
+	return EqualResultFunc(a, b, func(a, b T) bool { return a == b })
+}
+
+// EqualResultFunc reports whether both Results are Ok with values equal according to eq or both are not Ok with the same error,
+// errors of an uncomparable type are matched with errors.Is instead of ==
+func EqualResultFunc[T any](a, b Result[T], eq func(a, b T) bool) bool {
+	if a.IsOk() && b.IsOk() {
+		return eq(*a.t, *b.t)
+	}
+	if a.IsOk() || b.IsOk() {
+		return false
+	}
+	return sameError(untraced(a.err()), untraced(b.err()))
+}
+
+// sameError compares errors with == when their dynamic type allows it, comparing
+// two values of the same uncomparable type with == would panic
+func sameError(a, b error) bool {
+	if t := reflect.TypeOf(a); t != nil && t == reflect.TypeOf(b) && !t.Comparable() {
+		return errors.Is(a, b)
+	}
+	return a == b
+}
+
+// CompareResult orders Results, errors sort before any Ok value and are equal to each other
+func CompareResult[T cmp.Ordered](a, b Result[T]) int {
+	return CompareResultFunc(a, b, cmp.Compare[T])
+}
+
+// CompareResultFunc orders Results with the provided callback, errors sort before any Ok value and are equal to each other
+func CompareResultFunc[T any](a, b Result[T], fn func(a, b T) int) int {
+	switch {
+	case !a.IsOk() && !b.IsOk():
+		return 0
+	case !a.IsOk():
+		return -1
+	case !b.IsOk():
+		return 1
+	}
+	return fn(*a.t, *b.t)
+}
+
+// ContainsOk reports whether the Result is Ok and holds v
+func ContainsOk[T comparable](r Result[T], v T) bool {
+	return r.IsOk() && *r.t == v
+}
+
+// ContainsErr reports whether the Result is not Ok and its error matches target according to errors.Is
+func ContainsErr[T any](r Result[T], target error) bool {
+	return !r.IsOk() && errors.Is(r.err(), target)
+}
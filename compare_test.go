@@ -0,0 +1,105 @@
+package option_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/debudda/option"
+)
+
+func ExampleEqual() {
+	fmt.Println(option.Equal(option.O(1), option.O(1)))
+	fmt.Println(option.Equal(option.O(1), option.O(2)))
+	fmt.Println(option.Equal(option.O[int](), option.O[int]()))
+	fmt.Println(option.Equal(option.O(0), option.O[int]()))
+	// Output: true
+	// false
+	// true
+	// false
+}
+
+func ExampleEqualFunc() {
+	fmt.Println(option.EqualFunc(option.O("Go"), option.O("GO"), strings.EqualFold))
+	// Output: true
+}
+
+func ExampleCompare() {
+	opts := option.Options[int]{option.O(3), option.O[int](), option.O(1), option.O(2)}
+	slices.SortFunc(opts, option.Compare[int])
+	fmt.Println(opts)
+	slices.SortFunc(opts, option.NoneLast(option.Compare[int]))
+	fmt.Println(opts)
+	// Output: [None Some(1) Some(2) Some(3)]
+	// [Some(1) Some(2) Some(3) None]
+}
+
+func ExampleCompareFunc() {
+	byAge := func(a, b Writer) int { return a.Age - b.Age }
+	opts := slices.Clone(options)
+	slices.SortFunc(opts, option.NoneLast(func(a, b option.Option[Writer]) int {
+		return option.CompareFunc(a, b, byAge)
+	}))
+	for _, o := range opts {
+		fmt.Println(o.Switchv(
+			func(w Writer) Writer { return w },
+			func() Writer { return Writer{Name: "nobody"} }).Name)
+	}
+	// Output: Douglas Adams
+	// Neil Gaiman
+	// Neal Stephenson
+	// nobody
+}
+
+func ExampleContains() {
+	fmt.Println(option.Contains(option.O("a"), "a"), option.Contains(option.O[string](), ""))
+	// Output: true false
+}
+
+func ExampleKeyOf() {
+	seen := map[option.Key[int]]int{}
+	opts := option.Options[int]{option.O(1), option.O[int](), option.O(1), option.O[int](), option.O(0)}
+	for _, o := range opts {
+		seen[option.KeyOf(o)]++
+	}
+	fmt.Println(seen[option.KeyOf(option.O(1))], seen[option.KeyOf(option.O[int]())], seen[option.KeyOf(option.O(0))])
+	fmt.Println(option.KeyOf(option.O(5)).Option())
+	// Output: 2 2 1
+	// Some(5)
+}
+
+func ExampleEqualResult() {
+	errBoom := errors.New("boom")
+	fmt.Println(option.EqualResult(option.Ok(1), option.Ok(1)))
+	fmt.Println(option.EqualResult(option.Err[int](errBoom), option.Err[int](errBoom)))
+	fmt.Println(option.EqualResult(option.Err[int](errBoom), option.Err[int](errors.New("boom"))))
+	fmt.Println(option.EqualResult(option.Ok(0), option.Result[int]{}))
+	fmt.Println(option.EqualResult(option.Err[int](errList{errBoom}), option.Err[int](errList{errBoom})))
+	// Output: true
+	// true
+	// false
+	// false
+	// false
+}
+
+// errList is an error of an uncomparable type
+type errList []error
+
+func (e errList) Error() string { return fmt.Sprint([]error(e)) }
+
+func ExampleCompareResult() {
+	rs := []option.Result[int]{option.Ok(2), option.Err[int](errors.New("boom")), option.Ok(1)}
+	slices.SortFunc(rs, option.CompareResult[int])
+	fmt.Println(rs)
+	// Output: [Err(boom) Ok(1) Ok(2)]
+}
+
+func ExampleContainsErr() {
+	errBoom := errors.New("boom")
+	r := option.Err[int](fmt.Errorf("wrapped: %w", errBoom))
+	fmt.Println(option.ContainsErr(r, errBoom), option.ContainsOk(r, 0), option.ContainsOk(option.Ok(1), 1))
+	fmt.Println(option.ResultKeyOf(r).Result())
+	// Output: true false true
+	// Err(wrapped: boom)
+}
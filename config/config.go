@@ -0,0 +1,156 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/debudda/option"
+)
+
+// LookupFunc is used to read a variable, same signature as os.LookupEnv
+type LookupFunc func(key string) (string, bool)
+
+// ErrNotStruct is returned when the destination is not a pointer to a struct
+var ErrNotStruct = errors.New("config: destination must be a pointer to a struct")
+
+type field struct {
+	name  string
+	usage string
+	path  string
+	value flag.Value
+}
+
+// Env populates the fields of dst tagged with `env:"NAME"` from the environment.
+// Unset variables leave the field untouched, so an Option field stays None,
+// all parsing errors are collected and returned as a single Err
+func Env[T any](dst *T) option.Result[*T] {
+	return EnvLookup(dst, os.LookupEnv)
+}
+
+// EnvLookup is the same as Env but reads variables with the provided lookup function
+func EnvLookup[T any](dst *T, lookup LookupFunc) option.Result[*T] {
+	fields, err := collect(dst, "env")
+	if err != nil {
+		return option.Err[*T](err)
+	}
+	var errs []error
+	for _, f := range fields {
+		s, ok := lookup(f.name)
+		if !ok {
+			continue
+		}
+		if err := f.value.Set(s); err != nil {
+			errs = append(errs, fmt.Errorf("config: env %s (%s): %w", f.name, f.path, err))
+		}
+	}
+	if len(errs) > 0 {
+		return option.Err[*T](errors.Join(errs...))
+	}
+	return option.Ok(dst)
+}
+
+// Flags registers the fields of dst tagged with `flag:"name"` in fs and parses args.
+// An optional `usage:"..."` tag is used as the flag description. Flags which are not
+// provided leave the field untouched, all parsing errors are collected and returned as a single Err
+func Flags[T any](fs *flag.FlagSet, dst *T, args []string) option.Result[*T] {
+	fields, err := collect(dst, "flag")
+	if err != nil {
+		return option.Err[*T](err)
+	}
+	var errs []error
+	for _, f := range fields {
+		fs.Var(&collector{field: f, errs: &errs}, f.name, f.usage)
+	}
+	if err := fs.Parse(args); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return option.Err[*T](errors.Join(errs...))
+	}
+	return option.Ok(dst)
+}
+
+// collector wraps a field's flag.Value and records errors instead of stopping flag parsing
+type collector struct {
+	field
+	errs *[]error
+}
+
+// String returns the default shown by flag.PrintDefaults, the zero collector returns the same
+// empty string as a None Option so that flag does not print a default for unset fields
+func (c *collector) String() string {
+	if c.value == nil {
+		return ""
+	}
+	return c.value.String()
+}
+
+func (c *collector) Set(s string) error {
+	if err := c.value.Set(s); err != nil {
+		*c.errs = append(*c.errs, fmt.Errorf("config: flag -%s (%s): %w", c.name, c.path, err))
+	}
+	return nil
+}
+
+func (c *collector) IsBoolFlag() bool {
+	b, ok := c.value.(interface{ IsBoolFlag() bool })
+	return ok && b.IsBoolFlag()
+}
+
+// flagValue prefers the FlagValue adapter of option.Option, which prints bare values, over the type's own String
+func flagValue(v any) (flag.Value, bool) {
+	if o, ok := v.(interface{ FlagValue() flag.Value }); ok {
+		return o.FlagValue(), true
+	}
+	value, ok := v.(flag.Value)
+	return value, ok
+}
+
+func collect(dst any, tag string) ([]field, error) {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil, ErrNotStruct
+	}
+	var (
+		fields []field
+		errs   []error
+	)
+	walk(rv.Elem(), tag, "", &fields, &errs)
+	return fields, errors.Join(errs...)
+}
+
+func walk(rv reflect.Value, tag, prefix string, fields *[]field, errs *[]error) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		path := prefix + sf.Name
+		fv := rv.Field(i)
+		name, ok := sf.Tag.Lookup(tag)
+		if !ok {
+			if _, isValue := fv.Addr().Interface().(flag.Value); !isValue && sf.Type.Kind() == reflect.Struct {
+				walk(fv, tag, path+".", fields, errs)
+			}
+			continue
+		}
+		if name == "" || name == "-" {
+			continue
+		}
+		value, isValue := flagValue(fv.Addr().Interface())
+		if !isValue {
+			*errs = append(*errs, fmt.Errorf("config: field %s of type %s does not implement flag.Value", path, sf.Type))
+			continue
+		}
+		*fields = append(*fields, field{
+			name:  name,
+			usage: sf.Tag.Get("usage"),
+			path:  path,
+			value: value,
+		})
+	}
+}
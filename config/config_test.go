@@ -0,0 +1,121 @@
+package config_test
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/debudda/option"
+	"github.com/debudda/option/config"
+)
+
+type Settings struct {
+	Port    option.Option[int]           `env:"PORT" flag:"port" usage:"port to listen on"`
+	Host    option.Option[string]        `env:"HOST" flag:"host"`
+	Debug   option.Option[bool]          `env:"DEBUG" flag:"debug"`
+	Timeout option.Option[time.Duration] `env:"TIMEOUT" flag:"timeout"`
+}
+
+func env(vars map[string]string) config.LookupFunc {
+	return func(key string) (string, bool) {
+		v, ok := vars[key]
+		return v, ok
+	}
+}
+
+func ExampleEnvLookup() {
+	var s Settings
+	config.EnvLookup(&s, env(map[string]string{
+		"PORT":    "8080",
+		"TIMEOUT": "5s",
+	})).Switch(
+		func(s *Settings) {
+			fmt.Println(s.Port.Default(0), s.Host.IsNone(), s.Debug.IsNone(), s.Timeout.Default(0))
+		},
+		func(err error) {
+			fmt.Println(err)
+		})
+	// Output: 8080 true true 5s
+}
+
+func ExampleEnvLookup_errors() {
+	var s Settings
+	config.EnvLookup(&s, env(map[string]string{
+		"PORT":  "eighty",
+		"DEBUG": "maybe",
+	})).Switch(
+		func(s *Settings) {
+			fmt.Println("unexpected success")
+		},
+		func(err error) {
+			fmt.Println(err)
+		})
+	// Output: config: env PORT (Port): strconv.ParseInt: parsing "eighty": invalid syntax
+	// config: env DEBUG (Debug): strconv.ParseBool: parsing "maybe": invalid syntax
+}
+
+func ExampleFlags() {
+	var s Settings
+	fs := flag.NewFlagSet("app", flag.ContinueOnError)
+	config.Flags(fs, &s, []string{"-port", "9090", "-debug"}).Ok(func(s *Settings) {
+		fmt.Println(s.Port.Default(0), s.Host.IsNone(), s.Debug.Default(false))
+	})
+	// Output: 9090 true true
+}
+
+func ExampleFlags_defaults() {
+	var s struct {
+		Host  option.Option[string] `flag:"host" usage:"host to bind"`
+		Port  option.Option[int]    `flag:"port" usage:"port to listen on"`
+		Debug option.Option[bool]   `flag:"debug" usage:"enable debug logs"`
+	}
+	s.Host = option.O("localhost")
+	fs := flag.NewFlagSet("app", flag.ContinueOnError)
+	fs.SetOutput(os.Stdout)
+	config.Flags(fs, &s, nil)
+	fs.PrintDefaults()
+	// Output:
+	//   -debug
+	//     	enable debug logs
+	//   -host value
+	//     	host to bind (default localhost)
+	//   -port value
+	//     	port to listen on
+}
+
+func ExampleFlags_errors() {
+	var s Settings
+	fs := flag.NewFlagSet("app", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	err := config.Flags(fs, &s, []string{"-port", "x", "-timeout", "soon", "-host", "localhost"}).OkErr(func(*Settings) {})
+	fmt.Println(err)
+	fmt.Println(s.Host.Default(""))
+	// Output: config: flag -port (Port): strconv.ParseInt: parsing "x": invalid syntax
+	// config: flag -timeout (Timeout): time: invalid duration "soon"
+	// localhost
+}
+
+func ExampleEnv_notStruct() {
+	n := 1
+	err := config.Env(&n).OkErr(func(*int) {})
+	fmt.Println(errors.Is(err, config.ErrNotStruct))
+	// Output: true
+}
+
+func ExampleEnvLookup_nested() {
+	type Database struct {
+		URL option.Option[string] `env:"DB_URL"`
+	}
+	type App struct {
+		Name option.Option[string] `env:"NAME"`
+		DB   Database
+		Bad  int `env:"BAD"`
+	}
+	var a App
+	err := config.EnvLookup(&a, env(map[string]string{"DB_URL": "postgres://"})).OkErr(func(*App) {})
+	fmt.Println(err)
+	// Output: config: field Bad of type int does not implement flag.Value
+}
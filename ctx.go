@@ -0,0 +1,49 @@
+package option
+
+import (
+	"context"
+	"time"
+)
+
+// ResultCtxFunc is a cancellable computation producing a Result
+type ResultCtxFunc[T any] func(ctx context.Context) Result[T]
+
+// asyncFunc adapts a ResultCtxFunc for Async
+func (fn ResultCtxFunc[T]) asyncFunc() AsyncFunc[T] {
+	return func(ctx context.Context) (T, error) {
+		res := fn(ctx)
+		if res.IsOk() {
+			return *res.t, nil
+		}
+		return *new(T), res.err()
+	}
+}
+
+// ThenCtx calls fn with the Ok value of r unless ctx is already done, in which case the cause of
+// cancellation is returned, errors of r are passed through without calling fn
+func ThenCtx[T, R any](ctx context.Context, r Result[T], fn func(ctx context.Context, v T) Result[R]) Result[R] {
+	if ctx.Err() != nil {
+		return Err[R](context.Cause(ctx))
+	}
+	if !r.IsOk() {
+		return Err[R](r.err())
+	}
+	return fn(ctx, *r.t)
+}
+
+// WithTimeout runs fn with a context limited to d and returns context.DeadlineExceeded as soon as
+// the time is up, even if fn ignores its context
+func WithTimeout[T any](ctx context.Context, d time.Duration, fn ResultCtxFunc[T]) Result[T] {
+	ctx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+	return Async(ctx, fn.asyncFunc()).Await()
+}
+
+// RaceCtx runs every fn concurrently and returns the first Result, the remaining calls are cancelled
+func RaceCtx[T any](ctx context.Context, fns ...ResultCtxFunc[T]) Result[T] {
+	fs := make([]*Future[T], len(fns))
+	for i, fn := range fns {
+		fs[i] = Async(ctx, fn.asyncFunc())
+	}
+	return Race(fs...).Await()
+}
@@ -0,0 +1,61 @@
+package option_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/debudda/option"
+)
+
+func sleepOk[T any](d time.Duration, v T) option.ResultCtxFunc[T] {
+	return func(ctx context.Context) option.Result[T] {
+		select {
+		case <-time.After(d):
+			return option.Ok(v)
+		case <-ctx.Done():
+			return option.Err[T](ctx.Err())
+		}
+	}
+}
+
+func ExampleThenCtx() {
+	parse := func(ctx context.Context, s string) option.Result[int] {
+		return option.Try(strconv.Atoi(s))
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	fmt.Println(option.ThenCtx(ctx, option.Ok("42"), parse))
+	fmt.Println(option.ThenCtx(ctx, option.Err[string](errors.New("no input")), parse))
+	cancel()
+	fmt.Println(option.ThenCtx(ctx, option.Ok("42"), parse))
+	// Output: Ok(42)
+	// Err(no input)
+	// Err(context canceled)
+}
+
+func ExampleWithTimeout() {
+	ctx := context.Background()
+	fmt.Println(option.WithTimeout(ctx, time.Second, sleepOk(0, "fast")))
+	fmt.Println(option.WithTimeout(ctx, time.Millisecond, sleepOk(time.Hour, "slow")))
+	// ignoring the context does not block the caller
+	fmt.Println(option.WithTimeout(ctx, time.Millisecond, func(context.Context) option.Result[string] {
+		time.Sleep(50 * time.Millisecond)
+		return option.Ok("stubborn")
+	}))
+	// Output: Ok(fast)
+	// Err(context deadline exceeded)
+	// Err(context deadline exceeded)
+}
+
+func ExampleRaceCtx() {
+	ctx := context.Background()
+	fmt.Println(option.RaceCtx(ctx, sleepOk(time.Hour, "slow"), sleepOk(time.Millisecond, "fast")))
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	fmt.Println(option.RaceCtx(cancelled, sleepOk(time.Hour, "slow")))
+	// Output: Ok(fast)
+	// Err(context canceled)
+}
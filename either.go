@@ -0,0 +1,213 @@
+package option
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+type (
+	// Left funcs
+	LeftFunc[L any] func(l L)
+	// Right funcs
+	RightFunc[R any] func(r R)
+)
+
+// ErrEitherType is returned when decoding JSON with a type other than left or right
+var ErrEitherType = errors.New("either type must be left or right")
+
+// Either holds exactly one of two values, by convention Right is the primary outcome
+type Either[L, R any] struct {
+	l *L
+	r *R
+}
+
+// Left constructs an Either holding the left value
+func Left[L, R any](l L) Either[L, R] {
+	return Either[L, R]{l: &l}
+}
+
+// Right constructs an Either holding the right value
+func Right[L, R any](r R) Either[L, R] {
+	return Either[L, R]{r: &r}
+}
+
+// EitherFromResult converts a Result into an Either with the error as Left
+func EitherFromResult[T any](res Result[T]) Either[error, T] {
+	if res.IsOk() {
+		return Right[error](*res.t)
+	}
+	return Left[error, T](res.err())
+}
+
+func (e Either[L, R]) IsLeft() bool {
+	return e.l != nil
+}
+
+func (e Either[L, R]) IsRight() bool {
+	return !e.IsLeft()
+}
+
+// LeftOption returns the left value as an Option
+func (e Either[L, R]) LeftOption() Option[L] {
+	return Option[L]{some: e.l}
+}
+
+// RightOption returns the right value as an Option
+func (e Either[L, R]) RightOption() Option[R] {
+	if e.IsLeft() {
+		return O[R]()
+	}
+	return Option[R]{some: e.right()}
+}
+
+// right returns the right value, the zero Either holds the zero value of R
+func (e Either[L, R]) right() *R {
+	if e.r == nil {
+		return new(R)
+	}
+	return e.r
+}
+
+// Switch is used to work with the value in the Either container, returns true if the value is Right
+func (e Either[L, R]) Switch(
+	l LeftFunc[L],
+	r RightFunc[R],
+) bool {
+	if e.IsLeft() {
+		l(*e.l)
+		return false
+	}
+	r(*e.right())
+	return true
+}
+
+// Swap returns an Either with the left and right values exchanged
+func (e Either[L, R]) Swap() Either[R, L] {
+	if e.IsLeft() {
+		return Right[R](*e.l)
+	}
+	return Left[R, L](*e.right())
+}
+
+// MapLeft is used to transform the left value of the Either
+func MapLeft[L, R, NL any](e Either[L, R], fn func(l L) NL) Either[NL, R] {
+	if e.IsLeft() {
+		return Left[NL, R](fn(*e.l))
+	}
+	return Right[NL](*e.right())
+}
+
+// MapRight is used to transform the right value of the Either
+func MapRight[L, R, NR any](e Either[L, R], fn func(r R) NR) Either[L, NR] {
+	if e.IsLeft() {
+		return Left[L, NR](*e.l)
+	}
+	return Right[L](fn(*e.right()))
+}
+
+// MapEither is used to transform both values of the Either
+func MapEither[L, R, NL, NR any](e Either[L, R], lfn func(l L) NL, rfn func(r R) NR) Either[NL, NR] {
+	if e.IsLeft() {
+		return Left[NL, NR](lfn(*e.l))
+	}
+	return Right[NL](rfn(*e.right()))
+}
+
+// Fold reduces the Either to a single value
+func Fold[L, R, V any](e Either[L, R], lfn func(l L) V, rfn func(r R) V) V {
+	if e.IsLeft() {
+		return lfn(*e.l)
+	}
+	return rfn(*e.right())
+}
+
+// EitherToResult converts an Either with an error as Left into a Result
+func EitherToResult[T any](e Either[error, T]) Result[T] {
+	if e.IsLeft() {
+		return Err[T](*e.l)
+	}
+	return Ok(*e.right())
+}
+
+const (
+	eitherLeft  = "left"
+	eitherRight = "right"
+)
+
+// MarshalJSON encodes the Either as {"type": "left", "value": ...} or {"type": "right", "value": ...}
+func (e Either[L, R]) MarshalJSON() ([]byte, error) {
+	if e.IsLeft() {
+		return json.Marshal(struct {
+			Type  string `json:"type"`
+			Value L      `json:"value"`
+		}{eitherLeft, *e.l})
+	}
+	return json.Marshal(struct {
+		Type  string `json:"type"`
+		Value R      `json:"value"`
+	}{eitherRight, *e.right()})
+}
+
+// UnmarshalJSON decodes the Either encoded by MarshalJSON
+func (e *Either[L, R]) UnmarshalJSON(bytes []byte) error {
+	var raw struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(bytes, &raw); err != nil {
+		return err
+	}
+	switch raw.Type {
+	case eitherLeft:
+		var l L
+		if err := json.Unmarshal(raw.Value, &l); err != nil {
+			return err
+		}
+		*e = Left[L, R](l)
+	case eitherRight:
+		var r R
+		if err := json.Unmarshal(raw.Value, &r); err != nil {
+			return err
+		}
+		*e = Right[L](r)
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrEitherType, raw.Type)
+	}
+	return nil
+}
+
+// Eithers is a slice of Either values
+type Eithers[L, R any] []Either[L, R]
+
+// Lefts returns all left values
+func (es Eithers[L, R]) Lefts() (res []L) {
+	for _, e := range es {
+		if e.IsLeft() {
+			res = append(res, *e.l)
+		}
+	}
+	return
+}
+
+// Rights returns all right values
+func (es Eithers[L, R]) Rights() (res []R) {
+	for _, e := range es {
+		if e.IsRight() {
+			res = append(res, *e.right())
+		}
+	}
+	return
+}
+
+// Partition splits the values into lefts and rights keeping their order
+func (es Eithers[L, R]) Partition() ([]L, []R) {
+	return es.Lefts(), es.Rights()
+}
+
+// Each is used to iterate over the values calling the matching callback
+func (es Eithers[L, R]) Each(l LeftFunc[L], r RightFunc[R]) {
+	for _, e := range es {
+		e.Switch(l, r)
+	}
+}
@@ -0,0 +1,92 @@
+package option_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/debudda/option"
+)
+
+type Cached struct{ Key string }
+type Fetched struct {
+	Key string
+	Ms  int
+}
+
+func lookup(key string, hit bool) option.Either[Cached, Fetched] {
+	if hit {
+		return option.Left[Cached, Fetched](Cached{Key: key})
+	}
+	return option.Right[Cached](Fetched{Key: key, Ms: 12})
+}
+
+func ExampleEither_Switch() {
+	for _, hit := range []bool{true, false} {
+		lookup("user:1", hit).Switch(
+			func(c Cached) { fmt.Println("cache hit", c.Key) },
+			func(f Fetched) { fmt.Println("fetched", f.Key, "in", f.Ms, "ms") })
+	}
+	// Output: cache hit user:1
+	// fetched user:1 in 12 ms
+}
+
+func ExampleFold() {
+	source := func(e option.Either[Cached, Fetched]) string {
+		return option.Fold(e,
+			func(Cached) string { return "cache" },
+			func(Fetched) string { return "origin" })
+	}
+	fmt.Println(source(lookup("a", true)), source(lookup("a", false)))
+	// Output: cache origin
+}
+
+func ExampleMapRight() {
+	e := option.Right[string](21)
+	fmt.Println(option.MapRight(e, func(n int) int { return n * 2 }).RightOption())
+	fmt.Println(option.MapLeft(option.Left[string, int]("x"), strconv.Quote).LeftOption())
+	fmt.Println(option.MapEither(e, func(s string) int { return len(s) }, strconv.Itoa).Swap().LeftOption())
+	// Output: Some(42)
+	// Some("x")
+	// Some(21)
+}
+
+func ExampleEitherFromResult() {
+	e := option.EitherFromResult(option.Try(strconv.Atoi("x")))
+	fmt.Println(e.IsLeft())
+	fmt.Println(option.EitherToResult(e))
+	fmt.Println(option.EitherToResult(option.Right[error](1)))
+	// Output: true
+	// Err(strconv.Atoi: parsing "x": invalid syntax)
+	// Ok(1)
+}
+
+func ExampleEither_MarshalJSON() {
+	raw, _ := json.Marshal([]option.Either[string, int]{
+		option.Left[string, int]("miss"),
+		option.Right[string](7),
+	})
+	fmt.Println(string(raw))
+
+	var decoded []option.Either[string, int]
+	fmt.Println(json.Unmarshal(raw, &decoded), decoded[0].LeftOption(), decoded[1].RightOption())
+
+	var bad option.Either[string, int]
+	err := json.Unmarshal([]byte(`{"type":"middle"}`), &bad)
+	fmt.Println(errors.Is(err, option.ErrEitherType))
+	// Output: [{"type":"left","value":"miss"},{"type":"right","value":7}]
+	// <nil> Some(miss) Some(7)
+	// true
+}
+
+func ExampleEithers_Partition() {
+	es := option.Eithers[error, int]{
+		option.EitherFromResult(option.Try(strconv.Atoi("1"))),
+		option.EitherFromResult(option.Try(strconv.Atoi("x"))),
+		option.EitherFromResult(option.Try(strconv.Atoi("3"))),
+	}
+	errs, nums := es.Partition()
+	fmt.Println(len(errs), nums)
+	// Output: 1 [1 3]
+}
@@ -0,0 +1,102 @@
+package option
+
+import (
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Set parses s into the underlying type and makes the Option Some.
+// Register the Option with FlagValue so that flag prints its default as a bare value
+func (o *Option[T]) Set(s string) error {
+	v, err := parse[T](s)
+	if err != nil {
+		return err
+	}
+	o.some = &v
+	return nil
+}
+
+// IsBoolFlag reports whether the Option holds a bool so that flag accepts -name without a value
+func (o *Option[T]) IsBoolFlag() bool {
+	var v T
+	_, ok := any(v).(bool)
+	return ok
+}
+
+// FlagValue returns a flag.Value backed by the Option. Its String method returns the bare value,
+// or an empty string for None, so flag.PrintDefaults shows a default which can be parsed back
+func (o *Option[T]) FlagValue() flag.Value {
+	return optionFlag[T]{o: o}
+}
+
+type optionFlag[T any] struct {
+	o *Option[T]
+}
+
+func (f optionFlag[T]) String() string {
+	if f.o == nil || f.o.IsNone() {
+		return ""
+	}
+	return fmt.Sprint(*f.o.some)
+}
+
+func (f optionFlag[T]) Set(s string) error {
+	return f.o.Set(s)
+}
+
+func (f optionFlag[T]) IsBoolFlag() bool {
+	return f.o.IsBoolFlag()
+}
+
+// parse converts a string into T, supports encoding.TextUnmarshaler, time.Duration,
+// strings, bools and numbers, other types are decoded as JSON
+func parse[T any](s string) (T, error) {
+	var v T
+	if u, ok := any(&v).(encoding.TextUnmarshaler); ok {
+		return v, u.UnmarshalText([]byte(s))
+	}
+	rv := reflect.ValueOf(&v).Elem()
+	if rv.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		rv.SetInt(int64(d))
+		return v, err
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return v, err
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 0, rv.Type().Bits())
+		if err != nil {
+			return v, err
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 0, rv.Type().Bits())
+		if err != nil {
+			return v, err
+		}
+		rv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return v, err
+		}
+		rv.SetFloat(f)
+	default:
+		return v, json.Unmarshal([]byte(s), &v)
+	}
+	return v, nil
+}
@@ -0,0 +1,41 @@
+package option_test
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/debudda/option"
+)
+
+func ExampleOption_Set() {
+	var (
+		port option.Option[int]
+		name option.Option[string]
+	)
+	fs := flag.NewFlagSet("app", flag.ContinueOnError)
+	fs.Var(&port, "port", "port to listen on")
+	fs.Var(&name, "name", "service name")
+	if err := fs.Parse([]string{"-port", "8080"}); err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(port.Default(0), name.IsNone())
+	// Output: 8080 true
+}
+
+func ExampleOption_FlagValue() {
+	var (
+		port = option.O(8080)
+		name option.Option[string]
+	)
+	fs := flag.NewFlagSet("app", flag.ContinueOnError)
+	fs.SetOutput(os.Stdout)
+	fs.Var(port.FlagValue(), "port", "port to listen on")
+	fs.Var(name.FlagValue(), "name", "service name")
+	fs.PrintDefaults()
+	// Output:
+	//   -name value
+	//     	service name
+	//   -port value
+	//     	port to listen on (default 8080)
+}
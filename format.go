@@ -0,0 +1,71 @@
+package option
+
+import (
+	"fmt"
+	"reflect"
+)
+
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
+
+// String returns Some(v) or None
+func (o Option[T]) String() string {
+	return fmt.Sprintf("%v", o)
+}
+
+// GoString returns the Go syntax which constructs the Option, e.g. option.O[int](5)
+func (o Option[T]) GoString() string {
+	if o.IsNone() {
+		return fmt.Sprintf("option.O[%s]()", typeName[T]())
+	}
+	return fmt.Sprintf("option.O[%s](%#v)", typeName[T](), *o.some)
+}
+
+// Format implements fmt.Formatter, the verb and flags are passed through to the underlying value
+// and the result is wrapped as Some(...), None is always printed as None
+func (o Option[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprint(f, o.GoString())
+		return
+	}
+	if o.IsNone() {
+		fmt.Fprint(f, "None")
+		return
+	}
+	fmt.Fprintf(f, "Some("+fmt.FormatString(f, verb)+")", *o.some)
+}
+
+// String returns Ok(v) or Err(error)
+func (r Result[T]) String() string {
+	return fmt.Sprintf("%v", r)
+}
+
+// GoString returns the Go syntax which constructs the Result, e.g. option.Ok[int](5)
+func (r Result[T]) GoString() string {
+	if r.IsOk() {
+		return fmt.Sprintf("option.Ok[%s](%#v)", typeName[T](), *r.t)
+	}
+	if r.IsZero() {
+		return fmt.Sprintf("option.Result[%s]{}", typeName[T]())
+	}
+	return fmt.Sprintf("option.Err[%s](errors.New(%q))", typeName[T](), r.e.Error())
+}
+
+// Format implements fmt.Formatter, the verb and flags are passed through to either the Ok value or the error
+// and the result is wrapped as Ok(...) or Err(...)
+func (r Result[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprint(f, r.GoString())
+		return
+	}
+	if r.IsOk() {
+		fmt.Fprintf(f, "Ok("+fmt.FormatString(f, verb)+")", *r.t)
+		return
+	}
+	err := ErrUninitialized
+	if r.IsErr() {
+		err = r.e
+	}
+	fmt.Fprintf(f, "Err("+fmt.FormatString(f, verb)+")", err)
+}
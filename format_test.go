@@ -0,0 +1,52 @@
+package option_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/debudda/option"
+)
+
+func ExampleOption_String() {
+	fmt.Println(option.O(5))
+	fmt.Println(option.O[int]())
+	fmt.Println(option.Slice(1, 2))
+	// Output: Some(5)
+	// None
+	// [Some(1) Some(2)]
+}
+
+func ExampleOption_GoString() {
+	fmt.Printf("%#v\n", option.O(5))
+	fmt.Printf("%#v\n", option.O[string]())
+	fmt.Printf("%#v\n", option.O(User{Name: "Douglas Adams", Age: 42}))
+	// Output: option.O[int](5)
+	// option.O[string]()
+	// option.O[option_test.User](option_test.User{Name:"Douglas Adams", Age:42})
+}
+
+func ExampleOption_Format() {
+	fmt.Printf("%+v\n", option.O(User{Name: "Douglas Adams", Age: 42}))
+	fmt.Printf("%x %X %08.3f\n", option.O(255), option.O(255), option.O(3.14159))
+	fmt.Printf("%q %5s|\n", option.O("hi"), option.O("hi"))
+	fmt.Printf("%q %x\n", option.O[string](), option.O[int]())
+	// Output: Some({Name:Douglas Adams Age:42})
+	// Some(ff) Some(FF) Some(0003.142)
+	// Some("hi") Some(   hi)|
+	// None None
+}
+
+func ExampleResult_Format() {
+	fmt.Println(option.Ok(5))
+	fmt.Println(option.Err[int](errors.New("boom")))
+	fmt.Println(option.Result[int]{})
+	fmt.Printf("%x %q\n", option.Ok(255), option.Err[int](errors.New("boom")))
+	fmt.Printf("%#v\n", option.Ok("hi"))
+	fmt.Printf("%#v\n", option.Err[int](errors.New("boom")))
+	// Output: Ok(5)
+	// Err(boom)
+	// Err(result is uninitialized: result is not ok, but it's ok)
+	// Ok(ff) Err("boom")
+	// option.Ok[string]("hi")
+	// option.Err[int](errors.New("boom"))
+}
@@ -0,0 +1,195 @@
+package option
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrPanicked is used by Async when the computation panics, the panic value is part of the message
+var ErrPanicked = errors.New("async function panicked")
+
+// ErrNoFutures is used by Any and Race when they are called without Futures
+var ErrNoFutures = fmt.Errorf("no futures: %w", ErrNotOK)
+
+// AsyncFunc is a cancellable computation run by Async
+type AsyncFunc[T any] func(ctx context.Context) (T, error)
+
+// Future is a Result which is computed asynchronously
+type Future[T any] struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	res    Result[T]
+}
+
+// Async runs fn in a new goroutine and returns a Future of its Result.
+// The Future settles with ctx.Err() as soon as ctx is cancelled even if fn ignores it,
+// fn's goroutine exits once fn returns, so no goroutine is left blocked.
+// If fn panics the Future settles with ErrPanicked
+func Async[T any](ctx context.Context, fn AsyncFunc[T]) *Future[T] {
+	fctx, cancel := context.WithCancel(ctx)
+	f := &Future[T]{
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go func() {
+		out := make(chan Result[T], 1)
+		go func() {
+			defer func() {
+				if p := recover(); p != nil {
+					out <- Err[T](fmt.Errorf("%w: %v", ErrPanicked, p))
+				}
+			}()
+			out <- Try(fn(fctx))
+		}()
+		select {
+		case f.res = <-out:
+		case <-fctx.Done():
+			f.res = Err[T](context.Cause(fctx))
+		}
+		cancel()
+		close(f.done)
+	}()
+	return f
+}
+
+// Done returns a channel which is closed when the Future settles
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Cancel cancels the context of the computation, the Future settles with context.Canceled if it hasn't already
+func (f *Future[T]) Cancel() {
+	f.cancel()
+}
+
+// Await blocks until the Future settles and returns its Result
+func (f *Future[T]) Await() Result[T] {
+	<-f.done
+	return f.res
+}
+
+// AwaitTimeout waits for the Future for at most d, returns context.DeadlineExceeded on timeout
+// without cancelling the computation
+func (f *Future[T]) AwaitTimeout(d time.Duration) Result[T] {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-f.done:
+		return f.res
+	case <-timer.C:
+		return Err[T](context.DeadlineExceeded)
+	}
+}
+
+// Then runs fn with the Ok value of f once it settles, errors are passed through without calling fn
+func Then[T, R any](f *Future[T], fn func(ctx context.Context, v T) (R, error)) *Future[R] {
+	return Async(f.ctx, func(ctx context.Context) (R, error) {
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return *new(R), context.Cause(ctx)
+		}
+		if !f.res.IsOk() {
+			return *new(R), f.res.err()
+		}
+		return fn(ctx, *f.res.t)
+	})
+}
+
+// settled sends the index of every Future as it settles
+func settled[T any](fs []*Future[T]) <-chan int {
+	ch := make(chan int, len(fs))
+	for i, f := range fs {
+		go func(i int, f *Future[T]) {
+			<-f.done
+			ch <- i
+		}(i, f)
+	}
+	return ch
+}
+
+func cancelAll[T any](fs []*Future[T]) {
+	for _, f := range fs {
+		f.Cancel()
+	}
+}
+
+// next waits for the next settled Future, all Futures are cancelled if ctx is done first
+func next[T any](ctx context.Context, ch <-chan int, fs []*Future[T]) (int, error) {
+	select {
+	case i := <-ch:
+		return i, nil
+	case <-ctx.Done():
+		cancelAll(fs)
+		return 0, context.Cause(ctx)
+	}
+}
+
+// All settles with every Ok value in order or with the first error, the remaining Futures are cancelled on error.
+// Cancelling the returned Future cancels every input
+func All[T any](fs ...*Future[T]) *Future[[]T] {
+	return Async(context.Background(), func(ctx context.Context) ([]T, error) {
+		ch := settled(fs)
+		res := make([]T, len(fs))
+		for range fs {
+			i, err := next(ctx, ch, fs)
+			if err != nil {
+				return nil, err
+			}
+			if !fs[i].res.IsOk() {
+				cancelAll(fs)
+				return nil, fs[i].res.err()
+			}
+			res[i] = *fs[i].res.t
+		}
+		return res, nil
+	})
+}
+
+// Any settles with the first Ok value or with all errors joined if none succeeds, the remaining Futures are cancelled.
+// Without Futures it settles with ErrNoFutures.
+// Cancelling the returned Future cancels every input
+func Any[T any](fs ...*Future[T]) *Future[T] {
+	return Async(context.Background(), func(ctx context.Context) (T, error) {
+		ch := settled(fs)
+		errs := make([]error, 0, len(fs))
+		for range fs {
+			i, err := next(ctx, ch, fs)
+			if err != nil {
+				return *new(T), err
+			}
+			if fs[i].res.IsOk() {
+				cancelAll(fs)
+				return *fs[i].res.t, nil
+			}
+			errs = append(errs, fs[i].res.err())
+		}
+		if len(errs) == 0 {
+			errs = append(errs, ErrNoFutures)
+		}
+		return *new(T), errors.Join(errs...)
+	})
+}
+
+// Race settles with the Result of the first Future to settle, the remaining Futures are cancelled.
+// Without Futures it settles with ErrNoFutures.
+// Cancelling the returned Future cancels every input
+func Race[T any](fs ...*Future[T]) *Future[T] {
+	return Async(context.Background(), func(ctx context.Context) (T, error) {
+		if len(fs) == 0 {
+			return *new(T), ErrNoFutures
+		}
+		i, err := next(ctx, settled(fs), fs)
+		if err != nil {
+			return *new(T), err
+		}
+		cancelAll(fs)
+		return fs[i].res.Switchv(
+			func(t T) T { return t },
+			func(err error) error { return err })
+	})
+}
@@ -0,0 +1,173 @@
+package option_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/debudda/option"
+)
+
+func after[T any](d time.Duration, v T, err error) option.AsyncFunc[T] {
+	return func(ctx context.Context) (T, error) {
+		select {
+		case <-time.After(d):
+			return v, err
+		case <-ctx.Done():
+			return v, ctx.Err()
+		}
+	}
+}
+
+func ExampleAsync() {
+	f := option.Async(context.Background(), func(ctx context.Context) (int, error) {
+		return strconv.Atoi("42")
+	})
+	fmt.Println(f.Await())
+	// Output: Ok(42)
+}
+
+func ExampleFuture_AwaitTimeout() {
+	f := option.Async(context.Background(), after(time.Second, 1, nil))
+	defer f.Cancel()
+	fmt.Println(f.AwaitTimeout(time.Millisecond))
+	// Output: Err(context deadline exceeded)
+}
+
+func ExampleThen() {
+	f := option.Async(context.Background(), func(ctx context.Context) (string, error) {
+		return "21", nil
+	})
+	doubled := option.Then(option.Then(f, func(ctx context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	}), func(ctx context.Context, n int) (int, error) {
+		return n * 2, nil
+	})
+	fmt.Println(doubled.Await())
+
+	failed := option.Then(option.Async(context.Background(), after(0, "x", nil)), func(ctx context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	})
+	fmt.Println(failed.Await())
+	// Output: Ok(42)
+	// Err(strconv.Atoi: parsing "x": invalid syntax)
+}
+
+func ExampleAll() {
+	ctx := context.Background()
+	fmt.Println(option.All(
+		option.Async(ctx, after(2*time.Millisecond, 1, nil)),
+		option.Async(ctx, after(time.Millisecond, 2, nil)),
+	).Await())
+
+	slow := option.Async(ctx, after(time.Hour, 3, nil))
+	fmt.Println(option.All(
+		slow,
+		option.Async(ctx, after(time.Millisecond, 0, errors.New("boom"))),
+	).Await())
+	fmt.Println(slow.Await())
+	// Output: Ok([1 2])
+	// Err(boom)
+	// Err(context canceled)
+}
+
+func ExampleAny() {
+	ctx := context.Background()
+	fmt.Println(option.Any(
+		option.Async(ctx, after(time.Millisecond, 0, errors.New("boom"))),
+		option.Async(ctx, after(5*time.Millisecond, 2, nil)),
+	).Await())
+	fmt.Println(option.Any(
+		option.Async(ctx, after(0, 0, errors.New("a"))),
+		option.Async(ctx, after(0, 0, errors.New("a"))),
+	).Await())
+	// Output: Ok(2)
+	// Err(a
+	// a)
+}
+
+func ExampleRace() {
+	ctx := context.Background()
+	fmt.Println(option.Race(
+		option.Async(ctx, after(time.Millisecond, 0, errors.New("boom"))),
+		option.Async(ctx, after(time.Hour, 2, nil)),
+	).Await())
+	// Output: Err(boom)
+}
+
+func ExampleErrNoFutures() {
+	fmt.Println(option.Any[int]().Await(), option.Race[int]().Await())
+	fmt.Println(option.ContainsErr(option.Any[int]().Await(), option.ErrNoFutures), option.ContainsErr(option.Race[int]().Await(), option.ErrNoFutures))
+	// Output: Err(no futures: result is not ok, but it's ok) Err(no futures: result is not ok, but it's ok)
+	// true true
+}
+
+func TestAsync_cancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	fs := []*option.Future[int]{
+		option.Async(ctx, after(time.Hour, 1, nil)),
+		// ignores its context
+		option.Async(ctx, func(context.Context) (int, error) {
+			<-release
+			return 2, nil
+		}),
+	}
+	cancel()
+	for _, f := range fs {
+		if res := f.AwaitTimeout(time.Second); !option.ContainsErr(res, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", res)
+		}
+	}
+	close(release)
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("leaked goroutines: %d > %d", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestAll_cancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+	fs := []*option.Future[int]{
+		option.Async(context.Background(), after(time.Hour, 1, nil)),
+		option.Async(context.Background(), after(time.Hour, 2, nil)),
+	}
+	all := option.All(fs...)
+	all.Cancel()
+	if res := all.AwaitTimeout(time.Second); !option.ContainsErr(res, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", res)
+	}
+	for _, f := range fs {
+		if res := f.AwaitTimeout(time.Second); !option.ContainsErr(res, context.Canceled) {
+			t.Fatalf("expected input to be cancelled, got %v", res)
+		}
+	}
+	deadline := time.Now().Add(time.Second)
+	for runtime.NumGoroutine() > before {
+		if time.Now().After(deadline) {
+			t.Fatalf("leaked goroutines: %d > %d", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestAsync_panic(t *testing.T) {
+	f := option.Async(context.Background(), func(context.Context) (int, error) {
+		panic("boom")
+	})
+	res := f.Await()
+	if !option.ContainsErr(res, option.ErrPanicked) {
+		t.Fatalf("expected ErrPanicked, got %v", res)
+	}
+	if err := res.OkErr(func(int) {}); err.Error() != "async function panicked: boom" {
+		t.Fatalf("unexpected error %q", err)
+	}
+}
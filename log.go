@@ -0,0 +1,79 @@
+package option
+
+import "log/slog"
+
+// NoneLogValue is logged in place of a None Option
+var NoneLogValue = slog.StringValue("<none>")
+
+// RedactedLogValue is logged in place of a redacted value
+var RedactedLogValue = slog.StringValue("<redacted>")
+
+type logValuerFunc func() slog.Value
+
+func (fn logValuerFunc) LogValue() slog.Value {
+	return fn()
+}
+
+// LogValue implements slog.LogValuer, Some is logged as the underlying value
+// (honoring its own LogValue) and None as NoneLogValue
+func (o Option[T]) LogValue() slog.Value {
+	if o.IsNone() {
+		return NoneLogValue
+	}
+	return slog.AnyValue(*o.some).Resolve()
+}
+
+// Redacted returns a slog.LogValuer which hides the underlying value but still shows whether the Option is None
+func (o Option[T]) Redacted() slog.LogValuer {
+	return logValuerFunc(func() slog.Value {
+		if o.IsNone() {
+			return NoneLogValue
+		}
+		return RedactedLogValue
+	})
+}
+
+// LogValue implements slog.LogValuer, the Result is logged as a group with either an ok or an error attribute
+func (r Result[T]) LogValue() slog.Value {
+	if r.IsOk() {
+		return slog.GroupValue(slog.Any("ok", *r.t))
+	}
+	err := ErrUninitialized
+	if r.IsErr() {
+		err = r.e
+	}
+	return slog.GroupValue(slog.Any("error", err))
+}
+
+// Redacted returns a slog.LogValuer which hides the Ok value, errors are still logged
+func (r Result[T]) Redacted() slog.LogValuer {
+	return logValuerFunc(func() slog.Value {
+		if r.IsOk() {
+			return slog.GroupValue(slog.Attr{Key: "ok", Value: RedactedLogValue})
+		}
+		return r.LogValue()
+	})
+}
+
+// LogValue implements slog.LogValuer, Options are logged as a group holding the list of Some values and the number of None values
+func (opts Options[T]) LogValue() slog.Value {
+	some := make([]any, 0, len(opts))
+	Each(opts, func(t T) {
+		some = append(some, slog.AnyValue(t).Resolve().Any())
+	})
+	return slog.GroupValue(
+		slog.Any("some", some),
+		slog.Int("none", len(opts)-len(some)),
+	)
+}
+
+// Redacted returns a slog.LogValuer which only logs the number of Some and None values
+func (opts Options[T]) Redacted() slog.LogValuer {
+	return logValuerFunc(func() slog.Value {
+		some := Foldl(opts, func(n int, _ T) int { return n + 1 }, 0)
+		return slog.GroupValue(
+			slog.Int("some", some),
+			slog.Int("none", len(opts)-some),
+		)
+	})
+}
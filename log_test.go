@@ -0,0 +1,61 @@
+package option_test
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/debudda/option"
+)
+
+type Token string
+
+func (t Token) LogValue() slog.Value {
+	return slog.StringValue("token:" + string(t[:3]) + "...")
+}
+
+func newLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+}
+
+func ExampleOption_LogValue() {
+	log := newLogger()
+	log.Info("user", "age", option.O(42), "name", option.O[string]())
+	log.Info("auth", "token", option.O(Token("abcdef")))
+	// Output: msg=user age=42 name=<none>
+	// msg=auth token=token:abc...
+}
+
+func ExampleOption_Redacted() {
+	log := newLogger()
+	log.Info("auth", "password", option.O("hunter2").Redacted(), "otp", option.O[string]().Redacted())
+	// Output: msg=auth password=<redacted> otp=<none>
+}
+
+func ExampleResult_LogValue() {
+	log := newLogger()
+	log.Info("fetch", "user", option.Ok(User{Name: "Douglas Adams", Age: 42}))
+	log.Info("fetch", "user", option.Err[User](errors.New("not found")))
+	log.Info("fetch", "user", option.Result[User]{})
+	log.Info("fetch", "secret", option.Ok("hunter2").Redacted())
+	// Output: msg=fetch user.ok="{Name:Douglas Adams Age:42}"
+	// msg=fetch user.error="not found"
+	// msg=fetch user.error="result is uninitialized: result is not ok, but it's ok"
+	// msg=fetch secret.ok=<redacted>
+}
+
+func ExampleOptions_LogValue() {
+	log := newLogger()
+	opts := option.Options[int]{option.O(1), option.O[int](), option.O(3)}
+	log.Info("batch", "ids", opts)
+	log.Info("batch", "ids", opts.Redacted())
+	// Output: msg=batch ids.some="[1 3]" ids.none=1
+	// msg=batch ids.some=2 ids.none=1
+}
@@ -0,0 +1,50 @@
+package option
+
+// FromMap returns the value stored in m under k, None if the key is missing
+func FromMap[K comparable, V any](m map[K]V, k K) Option[V] {
+	v, ok := m[k]
+	return FromOk(v, ok)
+}
+
+// At returns the element of s at index i, None if i is out of range
+func At[T any](s []T, i int) Option[T] {
+	if i < 0 || i >= len(s) {
+		return O[T]()
+	}
+	return O(s[i])
+}
+
+// FromPtr returns None for a nil pointer, otherwise the Option shares the pointed value with p
+func FromPtr[T any](p *T) Option[T] {
+	return Option[T]{some: p}
+}
+
+// FromOk converts the comma ok idiom into an Option
+//
+//	option.FromOk(os.LookupEnv("HOME"))
+func FromOk[T any](v T, ok bool) Option[T] {
+	if !ok {
+		return O[T]()
+	}
+	return O(v)
+}
+
+// NonZero returns None if v is the zero value of its type
+func NonZero[T comparable](v T) Option[T] {
+	var zero T
+	if v == zero {
+		return O[T]()
+	}
+	return O(v)
+}
+
+// Cast is a safe type assertion, returns None if v does not hold a T
+func Cast[T any](v any) Option[T] {
+	t, ok := v.(T)
+	return FromOk(t, ok)
+}
+
+// Ptr returns a pointer to the underlying value or nil if None, the pointer is shared with the Option
+func (o Option[T]) Ptr() *T {
+	return o.some
+}
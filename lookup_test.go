@@ -0,0 +1,48 @@
+package option_test
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/debudda/option"
+)
+
+func ExampleFromMap() {
+	ages := map[string]int{"Douglas": 42, "Baby": 0}
+	fmt.Println(option.FromMap(ages, "Douglas"), option.FromMap(ages, "Baby"), option.FromMap(ages, "Neil"))
+	// Output: Some(42) Some(0) None
+}
+
+func ExampleAt() {
+	s := []string{"a", "b"}
+	fmt.Println(option.At(s, 1), option.At(s, 2), option.At(s, -1))
+	// Output: Some(b) None None
+}
+
+func ExampleFromPtr() {
+	n := 1
+	o := option.FromPtr(&n)
+	*o.Ptr() = 2
+	fmt.Println(o, n, option.FromPtr[int](nil), option.O[int]().Ptr() == nil)
+	// Output: Some(2) 2 None true
+}
+
+func ExampleFromOk() {
+	ch := make(chan int)
+	close(ch)
+	v, ok := <-ch
+	fmt.Println(option.FromOk(os.LookupEnv("OPTION_SURELY_UNSET")), option.FromOk(v, ok))
+	// Output: None None
+}
+
+func ExampleNonZero() {
+	fmt.Println(option.NonZero(""), option.NonZero("x"), option.NonZero(User{}), option.NonZero(0.5))
+	// Output: None Some(x) None Some(0.5)
+}
+
+func ExampleCast() {
+	var v any = os.Stdout
+	fmt.Println(option.Cast[io.Writer](v).IsSome(), option.Cast[string](v), option.Cast[int](42))
+	// Output: true None Some(42)
+}
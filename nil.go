@@ -0,0 +1,27 @@
+package option
+
+import "reflect"
+
+// isNil reports whether v is a nil pointer, map, slice, interface, func or channel.
+// Nilability is decided from the static type T, so values of types which can never
+// be nil, e.g. structs, arrays or named scalars, are not inspected with reflection
+func isNil[T any](v T) bool {
+	k := reflect.TypeFor[T]().Kind()
+	if !nilable(k) {
+		return false
+	}
+	if k == reflect.Interface && any(v) == nil {
+		return true
+	}
+	// an interface may hold a nil pointer, ValueOf inspects its dynamic value
+	rv := reflect.ValueOf(v)
+	return nilable(rv.Kind()) && rv.IsNil()
+}
+
+func nilable(k reflect.Kind) bool {
+	switch k {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package option_test
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"unsafe"
+
+	"github.com/debudda/option"
+)
+
+func ExampleSomeNonNil() {
+	var u *User
+	fmt.Println(option.O(u).IsSome(), option.SomeNonNil(u).IsSome())
+	fmt.Println(option.SomeNonNil(&User{Name: "Douglas Adams"}).IsSome())
+	// Output: true false
+	// true
+}
+
+func ExampleOkNonNil() {
+	var m map[string]int
+	fmt.Println(option.Ok(m).IsOk(), option.OkNonNil(m))
+	// Output: true Err(value is nil)
+}
+
+// nonNil reports whether SomeNonNil returns None and OkNonNil returns Err for v
+func nonNil[T any](v T) func() (bool, bool) {
+	return func() (bool, bool) {
+		return option.SomeNonNil(v).IsNone(), option.OkNonNil(v).IsErr()
+	}
+}
+
+func TestNonNil(t *testing.T) {
+	var (
+		ptr   *User
+		m     map[string]int
+		s     []int
+		iface io.Reader
+		fn    func()
+		ch    chan int
+		up    unsafe.Pointer
+	)
+	cases := []struct {
+		name  string
+		isNil bool
+		check func() (bool, bool)
+	}{
+		{"nil pointer", true, nonNil(ptr)},
+		{"pointer", false, nonNil(&User{})},
+		{"nil map", true, nonNil(m)},
+		{"map", false, nonNil(map[string]int{})},
+		{"nil slice", true, nonNil(s)},
+		{"empty slice", false, nonNil([]int{})},
+		{"nil interface", true, nonNil(iface)},
+		{"interface", false, nonNil[io.Reader](&io.LimitedReader{})},
+		{"nil any", true, nonNil[any](nil)},
+		{"nil func", true, nonNil(fn)},
+		{"func", false, nonNil(func() {})},
+		{"nil chan", true, nonNil(ch)},
+		{"chan", false, nonNil(make(chan int))},
+		{"nil unsafe pointer", true, nonNil(up)},
+		{"zero int", false, nonNil(0)},
+		{"empty string", false, nonNil("")},
+		{"zero struct", false, nonNil(User{})},
+		{"named string", false, nonNil(Token(""))},
+		{"array", false, nonNil([2]*User{})},
+		{"interface holding nil pointer", true, nonNil[io.Reader]((*io.LimitedReader)(nil))},
+		{"any holding nil map", true, nonNil[any](m)},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			isNone, isErr := c.check()
+			if isNone != c.isNil || isErr != c.isNil {
+				t.Fatalf("expected nil=%v, got None=%v Err=%v", c.isNil, isNone, isErr)
+			}
+		})
+	}
+}
+
+func TestOk_nil(t *testing.T) {
+	var u *User
+	if res := option.Ok(u); !res.IsOk() || res.MustPtr("ok") == nil || *res.MustPtr("ok") != nil {
+		t.Fatalf("expected Ok holding a nil pointer, got %v", res)
+	}
+	if o := option.O(u); !o.IsSome() {
+		t.Fatalf("expected Some holding a nil pointer, got %v", o)
+	}
+}
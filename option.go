@@ -31,7 +31,8 @@ func (o *Option[T]) UnmarshalJSON(bytes []byte) error {
 	return nil
 }
 
-// O is used to construct the Option value
+// O is used to construct the Option value, O() is None and O(v) is Some even if v is nil,
+// use SomeNonNil to treat nil values as None
 func O[T any](v ...T) Option[T] {
 	var t *T
 	if len(v) > 0 {
@@ -42,6 +43,14 @@ func O[T any](v ...T) Option[T] {
 	}
 }
 
+// SomeNonNil constructs the Option value, nil pointers, maps, slices, interfaces, funcs and channels are None
+func SomeNonNil[T any](v T) Option[T] {
+	if isNil(v) {
+		return O[T]()
+	}
+	return O(v)
+}
+
 func (o Option[T]) IsNone() bool {
 	return o.some == nil
 }
@@ -249,3 +258,40 @@ func And[F, S any](f Option[F], s Option[S]) Option[S] {
 	}
 	return s
 }
+
+// Copies of an Option share the underlying value: mutating it through SomePtr is visible
+// in every copy, while Take, Replace, Insert, GetOrInsertWith and Clear only rebind the
+// receiver and never write through the shared pointer.
+
+// Take moves the value out of the Option leaving None in its place
+func (o *Option[T]) Take() Option[T] {
+	taken := *o
+	o.some = nil
+	return taken
+}
+
+// Replace puts v into the Option and returns the previous value
+func (o *Option[T]) Replace(v T) Option[T] {
+	old := *o
+	o.some = &v
+	return old
+}
+
+// Insert puts v into the Option dropping the previous value and returns a pointer to the new one
+func (o *Option[T]) Insert(v T) *T {
+	o.some = &v
+	return o.some
+}
+
+// GetOrInsertWith returns a pointer to the underlying value, if None the value is produced by fn and inserted first
+func (o *Option[T]) GetOrInsertWith(fn func() T) *T {
+	if o.IsNone() {
+		return o.Insert(fn())
+	}
+	return o.some
+}
+
+// Clear makes the Option None
+func (o *Option[T]) Clear() {
+	o.some = nil
+}
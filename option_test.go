@@ -332,3 +332,67 @@ func ExampleOption_AndThen() {
 	})
 	// Output: 16
 }
+
+func ExampleOption_Take() {
+	maybeUser := option.O(User{Name: "Douglas Adams", Age: 42})
+	taken := maybeUser.Take()
+	fmt.Println(taken, maybeUser)
+	// Output: Some({Douglas Adams 42}) None
+}
+
+func ExampleOption_Replace() {
+	n := option.O(1)
+	old := n.Replace(2)
+	fmt.Println(old, n)
+	none := option.O[int]()
+	fmt.Println(none.Replace(3), none)
+	// Output: Some(1) Some(2)
+	// None Some(3)
+}
+
+func ExampleOption_Insert() {
+	var n option.Option[int]
+	p := n.Insert(1)
+	*p++
+	fmt.Println(n)
+	// Output: Some(2)
+}
+
+func ExampleOption_GetOrInsertWith() {
+	var cache option.Option[[]string]
+	calls := 0
+	load := func() []string {
+		calls++
+		return []string{"a"}
+	}
+	names := cache.GetOrInsertWith(load)
+	*names = append(*names, "b")
+	cache.GetOrInsertWith(load)
+	fmt.Println(cache, calls)
+	// Output: Some([a b]) 1
+}
+
+func ExampleOption_Clear() {
+	n := option.O(1)
+	n.Clear()
+	fmt.Println(n.IsNone())
+	// Output: true
+}
+
+func ExampleOption_Replace_aliasing() {
+	a := option.O(1)
+	b := a
+	// copies share the value, mutating through a pointer is visible in both
+	a.SomePtr(func(n *int) { *n = 2 })
+	fmt.Println(a, b)
+	// rebinding the receiver leaves other copies untouched
+	a.Replace(3)
+	*b.Insert(4) += 1
+	fmt.Println(a, b)
+	c := b
+	b.Clear()
+	fmt.Println(b, c)
+	// Output: Some(2) Some(2)
+	// Some(3) Some(5)
+	// None Some(5)
+}
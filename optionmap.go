@@ -0,0 +1,208 @@
+package option
+
+import (
+	"encoding/json"
+	"hash/maphash"
+	"iter"
+	"maps"
+	"sync"
+)
+
+// OptionMap is a map whose values can be explicitly None, a key holding None is known to be absent
+// while a missing key is unknown. It is encoded as a JSON object with null for None values
+type OptionMap[K comparable, V any] map[K]Option[V]
+
+// Get returns the value stored under k, None if the key is missing or holds None
+func (m OptionMap[K, V]) Get(k K) Option[V] {
+	return m[k]
+}
+
+// Lookup returns the Option stored under k and whether the key is present
+func (m OptionMap[K, V]) Lookup(k K) (Option[V], bool) {
+	o, ok := m[k]
+	return o, ok
+}
+
+// Has reports whether the key is present, even if it holds None
+func (m OptionMap[K, V]) Has(k K) bool {
+	_, ok := m[k]
+	return ok
+}
+
+// Set stores Some value under k
+func (m OptionMap[K, V]) Set(k K, v V) {
+	m[k] = O(v)
+}
+
+// SetNone marks k as known to be absent
+func (m OptionMap[K, V]) SetNone(k K) {
+	m[k] = O[V]()
+}
+
+// Delete removes k making it unknown
+func (m OptionMap[K, V]) Delete(k K) {
+	delete(m, k)
+}
+
+// All returns an iterator over the keys and Options of the map
+func (m OptionMap[K, V]) All() iter.Seq2[K, Option[V]] {
+	return maps.All(m)
+}
+
+// Some returns an iterator over the keys and values of the map skipping None values
+func (m OptionMap[K, V]) Some() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, o := range m {
+			if o.IsSome() && !yield(k, *o.some) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a new map with the entries whose values match a callback condition, None entries are dropped
+func (m OptionMap[K, V]) Filter(fn func(k K, v V) bool) OptionMap[K, V] {
+	res := OptionMap[K, V]{}
+	for k, v := range m.Some() {
+		if fn(k, v) {
+			res.Set(k, v)
+		}
+	}
+	return res
+}
+
+// MapValues returns a new map with Some values transformed with a callback, None values are kept
+func MapValues[K comparable, V any, R any](m OptionMap[K, V], fn func(k K, v V) R) OptionMap[K, R] {
+	res := make(OptionMap[K, R], len(m))
+	for k, o := range m {
+		res[k] = O[R]()
+		o.Some(func(v V) {
+			res.Set(k, fn(k, v))
+		})
+	}
+	return res
+}
+
+// shardCount is the number of shards of a ConcurrentOptionMap
+const shardCount = 32
+
+type shard[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  OptionMap[K, V]
+}
+
+// ConcurrentOptionMap is an OptionMap which can be safely shared between goroutines,
+// keys are spread over independently locked shards. The zero value is an empty map ready to use,
+// it must not be copied after first use. See MarshalJSON when embedding it in a struct by value
+type ConcurrentOptionMap[K comparable, V any] struct {
+	once   sync.Once
+	seed   maphash.Seed
+	shards [shardCount]shard[K, V]
+}
+
+// NewConcurrentOptionMap constructs an empty ConcurrentOptionMap
+func NewConcurrentOptionMap[K comparable, V any]() *ConcurrentOptionMap[K, V] {
+	return &ConcurrentOptionMap[K, V]{}
+}
+
+// shard returns the shard of k, the seed is created on first use so the zero value works
+func (m *ConcurrentOptionMap[K, V]) shard(k K) *shard[K, V] {
+	m.once.Do(func() {
+		m.seed = maphash.MakeSeed()
+	})
+	return &m.shards[maphash.Comparable(m.seed, k)%shardCount]
+}
+
+// Get returns the value stored under k, None if the key is missing or holds None
+func (m *ConcurrentOptionMap[K, V]) Get(k K) Option[V] {
+	o, _ := m.Lookup(k)
+	return o
+}
+
+// Lookup returns the Option stored under k and whether the key is present
+func (m *ConcurrentOptionMap[K, V]) Lookup(k K) (Option[V], bool) {
+	s := m.shard(k)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.m.Lookup(k)
+}
+
+// Has reports whether the key is present, even if it holds None
+func (m *ConcurrentOptionMap[K, V]) Has(k K) bool {
+	_, ok := m.Lookup(k)
+	return ok
+}
+
+// Set stores Some value under k
+func (m *ConcurrentOptionMap[K, V]) Set(k K, v V) {
+	m.store(k, O(v))
+}
+
+// SetNone marks k as known to be absent
+func (m *ConcurrentOptionMap[K, V]) SetNone(k K) {
+	m.store(k, O[V]())
+}
+
+func (m *ConcurrentOptionMap[K, V]) store(k K, o Option[V]) {
+	s := m.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.m == nil {
+		s.m = OptionMap[K, V]{}
+	}
+	s.m[k] = o
+}
+
+// Delete removes k making it unknown
+func (m *ConcurrentOptionMap[K, V]) Delete(k K) {
+	s := m.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.m, k)
+}
+
+// Len returns the number of keys including the ones holding None
+func (m *ConcurrentOptionMap[K, V]) Len() (n int) {
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		n += len(s.m)
+		s.mu.RUnlock()
+	}
+	return
+}
+
+// Snapshot copies the content into an OptionMap, each shard is copied atomically
+func (m *ConcurrentOptionMap[K, V]) Snapshot() OptionMap[K, V] {
+	res := OptionMap[K, V]{}
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		maps.Copy(res, s.m)
+		s.mu.RUnlock()
+	}
+	return res
+}
+
+// All returns an iterator over a Snapshot of the map, the callback can safely modify the map
+func (m *ConcurrentOptionMap[K, V]) All() iter.Seq2[K, Option[V]] {
+	return m.Snapshot().All()
+}
+
+// MarshalJSON encodes a Snapshot of the map. It has a pointer receiver because the map holds locks,
+// so a struct holding the map by value must be marshalled by pointer, e.g. json.Marshal(&doc),
+// otherwise encoding/json silently encodes the map as {}. Holding a *ConcurrentOptionMap avoids this
+func (m *ConcurrentOptionMap[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Snapshot())
+}
+
+func (m *ConcurrentOptionMap[K, V]) UnmarshalJSON(bytes []byte) error {
+	var decoded OptionMap[K, V]
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return err
+	}
+	for k, o := range decoded {
+		m.store(k, o)
+	}
+	return nil
+}
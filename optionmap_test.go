@@ -0,0 +1,142 @@
+package option_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/debudda/option"
+)
+
+func ExampleOptionMap() {
+	phones := option.OptionMap[string, string]{}
+	phones.Set("douglas", "+44 42")
+	phones.SetNone("neil")
+	for _, name := range []string{"douglas", "neil", "neal"} {
+		phone, known := phones.Lookup(name)
+		fmt.Println(name, phone, known)
+	}
+	phones.Delete("neil")
+	fmt.Println(phones.Has("neil"), phones.Get("douglas"))
+	// Output: douglas Some(+44 42) true
+	// neil None true
+	// neal None false
+	// false Some(+44 42)
+}
+
+func ExampleOptionMap_Filter() {
+	ages := option.OptionMap[string, int]{}
+	ages.Set("douglas", 49)
+	ages.Set("neil", 61)
+	ages.SetNone("unknown")
+	old := ages.Filter(func(_ string, age int) bool { return age > 50 })
+	fmt.Println(old)
+	doubled := option.MapValues(ages, func(_ string, age int) string { return strconv.Itoa(age * 2) })
+	fmt.Println(doubled)
+	fmt.Println(slices.Sorted(maps.Keys(maps.Collect(ages.Some()))))
+	// Output: map[neil:Some(61)]
+	// map[douglas:Some(98) neil:Some(122) unknown:None]
+	// [douglas neil]
+}
+
+func ExampleOptionMap_json() {
+	m := option.OptionMap[string, int]{}
+	m.Set("a", 1)
+	m.SetNone("b")
+	raw, _ := json.Marshal(m)
+	fmt.Println(string(raw))
+
+	var decoded option.OptionMap[string, int]
+	fmt.Println(json.Unmarshal([]byte(`{"x": 5, "y": null}`), &decoded), decoded, decoded.Has("y"))
+	// Output: {"a":1,"b":null}
+	// <nil> map[x:Some(5) y:None] true
+}
+
+func ExampleConcurrentOptionMap() {
+	m := option.NewConcurrentOptionMap[string, int]()
+	m.Set("a", 1)
+	m.SetNone("b")
+	fmt.Println(m.Get("a"), m.Has("b"), m.Has("c"), m.Len())
+	raw, _ := json.Marshal(m)
+	fmt.Println(string(raw))
+	// Output: Some(1) true false 2
+	// {"a":1,"b":null}
+}
+
+func ExampleConcurrentOptionMap_json() {
+	type Doc struct {
+		M option.ConcurrentOptionMap[string, int] `json:"m"`
+	}
+	var doc Doc
+	fmt.Println(json.Unmarshal([]byte(`{"m":{"a":1,"b":null}}`), &doc))
+	fmt.Println(doc.M.Get("a"), doc.M.Has("b"), doc.M.Len())
+	// a map held by value is only encoded when the struct is marshalled by pointer
+	raw, _ := json.Marshal(&doc)
+	fmt.Println(string(raw))
+	// Output: <nil>
+	// Some(1) true 2
+	// {"m":{"a":1,"b":null}}
+}
+
+func TestConcurrentOptionMap_marshalByValue(t *testing.T) {
+	type Doc struct {
+		M *option.ConcurrentOptionMap[string, int] `json:"m"`
+	}
+	var doc Doc
+	if err := json.Unmarshal([]byte(`{"m":{"a":1,"b":null}}`), &doc); err != nil {
+		t.Fatal(err)
+	}
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"m":{"a":1,"b":null}}`; string(raw) != want {
+		t.Fatalf("expected %s, got %s", want, raw)
+	}
+}
+
+func TestConcurrentOptionMap_concurrent(t *testing.T) {
+	// the zero value is initialized lazily by the first goroutine to use it
+	var m option.ConcurrentOptionMap[int, int]
+	var wg sync.WaitGroup
+	const workers, keys = 8, 500
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := 0; k < keys; k++ {
+				switch (k + w) % 4 {
+				case 0:
+					m.Set(k, w)
+				case 1:
+					m.SetNone(k)
+				case 2:
+					m.Get(k)
+				case 3:
+					m.Delete(k)
+				}
+				if k%100 == 0 {
+					for key := range m.All() {
+						m.Has(key)
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if n := m.Len(); n != len(m.Snapshot()) || n > keys {
+		t.Fatalf("unexpected length %d", n)
+	}
+	for k := 0; k < keys; k++ {
+		m.Set(k, k)
+	}
+	for k := 0; k < keys; k++ {
+		if got := m.Get(k).Default(-1); got != k {
+			t.Fatalf("expected %d, got %d", k, got)
+		}
+	}
+}
@@ -9,6 +9,10 @@ type (
 	MapFunci[T any, R any]  func(i int, some T) R
 	FoldFunc[T any, R any]  func(acc R, next T) R
 	FoldFunci[T any, R any] func(i int, acc R, next T) R
+	// short-circuiting folds
+	FoldWhileFunc[T any, R any]  func(acc R, next T) (R, bool)
+	TryFoldFunc[T any, R any]    func(acc R, next T) (R, error)
+	FoldResultFunc[T any, R any] func(acc R, next T) Result[R]
 	// temporary due to the limited nature of current generics implementation
 	MaptFunc[T any]  func(some T) T
 	MaptFunci[T any] func(i int, some T) T
@@ -55,10 +59,26 @@ func (opts Options[T]) OFilterPtri(fn FilterFunci[*T]) Options[T] {
 	return OFilterPtri(opts, fn)
 }
 
+func (opts Options[T]) FilterKeep(fn FilterFunc[T]) Options[T] {
+	return FilterKeep(opts, fn)
+}
+
+func (opts Options[T]) FillNone(def T) []T {
+	return FillNone(opts, def)
+}
+
+func (opts Options[T]) FillNoneWith(fn func(i int) T) []T {
+	return FillNoneWith(opts, fn)
+}
+
 func (opts Options[T]) Each(fn EachFunc[T]) {
 	Each(opts, fn)
 }
 
+func (opts Options[T]) EachUntil(fn FilterFunc[T]) bool {
+	return EachUntil(opts, fn)
+}
+
 func (opts Options[T]) EachPtr(fn EachFunc[*T]) {
 	EachPtr(opts, fn)
 }
@@ -99,6 +119,16 @@ func Each[T any](opts Options[T], fn EachFunc[T]) {
 	}
 }
 
+// EachUntil is used to iterate through Some values until the callback returns true, returns true if it stopped early
+func EachUntil[T any](opts Options[T], fn FilterFunc[T]) bool {
+	for _, opt := range opts {
+		if opt.IsSome() && fn(*opt.some) {
+			return true
+		}
+	}
+	return false
+}
+
 func EachPtr[T any](opts Options[T], fn EachFunc[*T]) {
 	for _, opt := range opts {
 		opt.SomePtr(func(some *T) {
@@ -138,6 +168,71 @@ func Mapi[T any, R any](opts Options[T], fn MapFunci[T, R]) (res []R) {
 	}, []R{})
 }
 
+// MapO is used to iterate through Options and create a new slice of Options of the same length,
+// Some values are transformed with a callback and None values are kept in place
+func MapO[T any, R any](opts Options[T], fn MapFunc[T, R]) Options[R] {
+	return MapOi(opts, func(_ int, some T) R {
+		return fn(some)
+	})
+}
+
+// MapOi is used to iterate through Options and create a new slice of Options of the same length,
+// allows accessing index of an element
+func MapOi[T any, R any](opts Options[T], fn MapFunci[T, R]) Options[R] {
+	res := make(Options[R], len(opts))
+	Eachi(opts, func(i int, some T) {
+		res[i] = O(fn(i, some))
+	})
+	return res
+}
+
+// FilterKeep is used to iterate through Options and create a new slice of Options of the same length
+// where values rejected by a callback become None
+func FilterKeep[T any](opts Options[T], fn FilterFunc[T]) Options[T] {
+	res := make(Options[T], len(opts))
+	Eachi(opts, func(i int, some T) {
+		if fn(some) {
+			res[i] = O(some)
+		}
+	})
+	return res
+}
+
+// FillNone is used to unpack Options into a slice of the same length replacing None with a default value
+func FillNone[T any](opts Options[T], def T) []T {
+	return FillNoneWith(opts, func(int) T {
+		return def
+	})
+}
+
+// FillNoneWith is used to unpack Options into a slice of the same length replacing None with a value
+// produced by a callback from the index of an element
+func FillNoneWith[T any](opts Options[T], fn func(i int) T) []T {
+	res := make([]T, len(opts))
+	for i, opt := range opts {
+		res[i] = opt.Switchv(
+			func(some T) T {
+				return some
+			},
+			func() T {
+				return fn(i)
+			})
+	}
+	return res
+}
+
+// ZipOptions aligns two slices of Options element by element, the result has the length of the longer slice
+// and holds None where either element is None or missing
+func ZipOptions[A any, B any](a Options[A], b Options[B]) Options[Pair[A, B]] {
+	res := make(Options[Pair[A, B]], max(len(a), len(b)))
+	for i := range res {
+		if i < len(a) && i < len(b) {
+			res[i] = Zip(a[i], b[i])
+		}
+	}
+	return res
+}
+
 // OFilter is used to iterate through Options and create a new slice of Options based on a provided callback condition
 func OFilter[T any](opts Options[T], fn FilterFunc[T]) Options[T] {
 	return Foldl(opts, func(res Options[T], next T) Options[T] {
@@ -228,6 +323,45 @@ func Foldl[T any, R any](opts Options[T], fn FoldFunc[T, R], start R) R {
 	return start
 }
 
+// FoldWhile is the same as Foldl but stops as soon as the callback returns false,
+// the accumulator returned along with false is the result
+func FoldWhile[T any, R any](opts Options[T], fn FoldWhileFunc[T, R], start R) R {
+	for _, opt := range opts {
+		if opt.IsNone() {
+			continue
+		}
+		var next bool
+		if start, next = fn(start, *opt.some); !next {
+			break
+		}
+	}
+	return start
+}
+
+// TryFold is the same as Foldl but stops at the first error returned by the callback
+func TryFold[T any, R any](opts Options[T], fn TryFoldFunc[T, R], start R) Result[R] {
+	_, res := FoldResult(opts, func(acc R, next T) Result[R] {
+		return Try(fn(acc, next))
+	}, start)
+	return res
+}
+
+// FoldResult is the same as Foldl but stops at the first Err returned by the callback,
+// returns the last successful accumulator alongside the final Result
+func FoldResult[T any, R any](opts Options[T], fn FoldResultFunc[T, R], start R) (R, Result[R]) {
+	for _, opt := range opts {
+		if opt.IsNone() {
+			continue
+		}
+		res := fn(start, *opt.some)
+		if !res.IsOk() {
+			return start, res
+		}
+		start = *res.t
+	}
+	return start, Ok(start)
+}
+
 // FoldlPtr is used to iterate over the Options and populate the provided R[esulting] value with the help of a callback
 func FoldlPtr[T any, R any](opts Options[T], fn FoldFunc[*T, R], start R) R {
 	for _, opt := range opts {
@@ -3,6 +3,7 @@ package option_test
 import (
 	"fmt"
 	"github.com/debudda/option"
+	"strconv"
 	"strings"
 )
 
@@ -99,3 +100,91 @@ func ExampleFoldri() {
 	fmt.Println(b.String())
 	// Output: Neal Stephenson and Neil Gaiman and Douglas Adams
 }
+
+func ExampleMapO() {
+	ages := option.MapO(options, func(w Writer) int {
+		return w.Age
+	})
+	fmt.Println(ages)
+	// Output: [Some(49) None Some(61) Some(62)]
+}
+
+func ExampleFilterKeep() {
+	fmt.Println(option.MapO(options.FilterKeep(func(w Writer) bool {
+		return w.Alive
+	}), func(w Writer) string {
+		return w.Name
+	}))
+	// Output: [None None Some(Neil Gaiman) Some(Neal Stephenson)]
+}
+
+func ExampleFillNone() {
+	headers := []string{"id", "name", "email"}
+	row := option.Options[string]{option.O("1"), option.O[string](), option.O("a@b.c")}
+	for i, v := range row.FillNone("-") {
+		fmt.Printf("%s=%s\n", headers[i], v)
+	}
+	fmt.Println(row.FillNoneWith(func(i int) string {
+		return "<" + headers[i] + ">"
+	}))
+	// Output: id=1
+	// name=-
+	// email=a@b.c
+	// [1 <name> a@b.c]
+}
+
+func ExampleZipOptions() {
+	names := option.Slice("a", "b", "c")
+	ages := option.Options[int]{option.O(1), option.O[int]()}
+	fmt.Println(option.ZipOptions(names, ages))
+	// Output: [Some({a 1}) None None]
+}
+
+func ExampleEachUntil() {
+	stopped := options.EachUntil(func(w Writer) bool {
+		fmt.Println(w.Name)
+		return w.Alive
+	})
+	fmt.Println(stopped)
+	// Output: Douglas Adams
+	// Neil Gaiman
+	// true
+}
+
+func ExampleFoldWhile() {
+	// sum ages until the total exceeds 100
+	total := option.FoldWhile(options, func(acc int, next Writer) (int, bool) {
+		acc += next.Age
+		return acc, acc <= 100
+	}, 0)
+	fmt.Println(total)
+	// Output: 110
+}
+
+func ExampleTryFold() {
+	nums := option.Slice("1", "2", "x", "4")
+	fmt.Println(option.TryFold(nums, func(acc int, next string) (int, error) {
+		n, err := strconv.Atoi(next)
+		return acc + n, err
+	}, 0))
+	fmt.Println(option.TryFold(nums[:2], func(acc int, next string) (int, error) {
+		n, err := strconv.Atoi(next)
+		return acc + n, err
+	}, 0))
+	// Output: Err(strconv.Atoi: parsing "x": invalid syntax)
+	// Ok(3)
+}
+
+func ExampleFoldResult() {
+	calls := 0
+	partial, res := option.FoldResult(option.Slice("1", "2", "x", "4"), func(acc int, next string) option.Result[int] {
+		calls++
+		n, err := strconv.Atoi(next)
+		if err != nil {
+			return option.Err[int](err)
+		}
+		return option.Ok(acc + n)
+	}, 0)
+	fmt.Println(partial, res, calls)
+	// Output: 3 Err(strconv.Atoi: parsing "x": invalid syntax) 3
+}
@@ -0,0 +1,141 @@
+package optiontest
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+
+	"github.com/debudda/option"
+)
+
+// resultPath is the import path of the package declaring option.Result
+var resultPath = reflect.TypeFor[option.Result[int]]().PkgPath()
+
+// Analyzer reports return statements which return an uninitialized option.Result:
+// an empty composite literal, a variable declared without a value and never assigned,
+// or a bare return of a named Result which is never assigned.
+// Assignments are tracked per function regardless of control flow, so a variable
+// assigned on any path is not reported
+var Analyzer = &analysis.Analyzer{
+	Name: "zeroresult",
+	Doc:  "report returns of uninitialized option.Result values, use option.Ok or option.Err instead",
+	Run:  run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch fn := n.(type) {
+			case *ast.FuncDecl:
+				if fn.Body != nil {
+					checkFunc(pass, fn.Type, fn.Body)
+				}
+			case *ast.FuncLit:
+				checkFunc(pass, fn.Type, fn.Body)
+			}
+			return true
+		})
+	}
+	return nil, nil
+}
+
+// checkFunc reports the uninitialized Results returned by a single function,
+// returns of nested function literals are checked on their own
+func checkFunc(pass *analysis.Pass, typ *ast.FuncType, body *ast.BlockStmt) {
+	var named []*types.Var
+	if typ.Results != nil {
+		for _, field := range typ.Results.List {
+			for _, name := range field.Names {
+				if v, ok := pass.TypesInfo.Defs[name].(*types.Var); ok && isResult(v.Type()) {
+					named = append(named, v)
+				}
+			}
+		}
+	}
+	zero := map[*types.Var]bool{}
+	for _, v := range named {
+		zero[v] = true
+	}
+	ast.Inspect(body, func(n ast.Node) bool {
+		if spec, ok := n.(*ast.ValueSpec); ok && len(spec.Values) == 0 {
+			for _, name := range spec.Names {
+				if v, ok := pass.TypesInfo.Defs[name].(*types.Var); ok && isResult(v.Type()) {
+					zero[v] = true
+				}
+			}
+		}
+		return true
+	})
+	// any assignment, including one in a nested function literal, initializes the variable
+	assigned := func(e ast.Expr) {
+		if id, ok := ast.Unparen(e).(*ast.Ident); ok {
+			if v, ok := pass.TypesInfo.Uses[id].(*types.Var); ok {
+				delete(zero, v)
+			}
+		}
+	}
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				assigned(lhs)
+			}
+		case *ast.RangeStmt:
+			if n.Tok == token.ASSIGN {
+				assigned(n.Key)
+				if n.Value != nil {
+					assigned(n.Value)
+				}
+			}
+		case *ast.UnaryExpr:
+			if n.Op == token.AND {
+				assigned(n.X)
+			}
+		}
+		return true
+	})
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			if len(n.Results) == 0 {
+				for _, v := range named {
+					if zero[v] {
+						pass.Reportf(n.Pos(), "returns an uninitialized Result %s, use option.Ok or option.Err", v.Name())
+					}
+				}
+			}
+			for _, res := range n.Results {
+				if isZeroResult(pass, res, zero) {
+					pass.Reportf(res.Pos(), "returns an uninitialized Result, use option.Ok or option.Err")
+				}
+			}
+		}
+		return true
+	})
+}
+
+func isZeroResult(pass *analysis.Pass, e ast.Expr, zero map[*types.Var]bool) bool {
+	switch e := ast.Unparen(e).(type) {
+	case *ast.CompositeLit:
+		return len(e.Elts) == 0 && isResult(pass.TypesInfo.TypeOf(e))
+	case *ast.Ident:
+		v, ok := pass.TypesInfo.Uses[e].(*types.Var)
+		return ok && zero[v]
+	}
+	return false
+}
+
+// isResult reports whether t is an instantiation of option.Result, aliases are resolved
+func isResult(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Origin().Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == resultPath && obj.Name() == "Result"
+}
@@ -0,0 +1,13 @@
+// Command zeroresult reports returns of uninitialized option.Result values,
+// it can be run on its own or with go vet -vettool=$(which zeroresult)
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/debudda/option/optiontest"
+)
+
+func main() {
+	singlechecker.Main(optiontest.Analyzer)
+}
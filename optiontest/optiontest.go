@@ -0,0 +1,57 @@
+// Package optiontest provides test helpers which catch misuse of option types
+package optiontest
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+
+	"github.com/debudda/option"
+)
+
+// Initialized fails the test if r is the uninitialized zero value of Result
+func Initialized[T any](t testing.TB, r option.Result[T]) option.Result[T] {
+	t.Helper()
+	if r.IsZero() {
+		t.Errorf("result of type %T is uninitialized", r)
+	}
+	return r
+}
+
+// NoZeroResults loads the non test packages matching patterns, e.g. "./...", with type information
+// and fails the test for every return of an uninitialized Result reported by Analyzer
+func NoZeroResults(t testing.TB, patterns ...string) {
+	t.Helper()
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax |
+			packages.NeedImports | packages.NeedDeps | packages.NeedTypes | packages.NeedTypesInfo | packages.NeedTypesSizes,
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	for _, pkg := range pkgs {
+		if len(pkg.Errors) > 0 {
+			t.Fatal(pkg.Errors[0])
+			return
+		}
+		pass := &analysis.Pass{
+			Analyzer:   Analyzer,
+			Fset:       pkg.Fset,
+			Files:      pkg.Syntax,
+			Pkg:        pkg.Types,
+			TypesInfo:  pkg.TypesInfo,
+			TypesSizes: pkg.TypesSizes,
+			ResultOf:   map[*analysis.Analyzer]any{},
+			Report: func(d analysis.Diagnostic) {
+				t.Errorf("%s: %s", pkg.Fset.Position(d.Pos), d.Message)
+			},
+		}
+		if _, err := Analyzer.Run(pass); err != nil {
+			t.Fatal(err)
+			return
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package optiontest_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/debudda/option"
+	"github.com/debudda/option/optiontest"
+)
+
+// recorder captures failures instead of failing the test
+type recorder struct {
+	testing.TB
+	errs []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errs = append(r.errs, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatal(args ...any) {
+	r.errs = append(r.errs, fmt.Sprint(args...))
+}
+
+func TestNoZeroResults(t *testing.T) {
+	optiontest.NoZeroResults(t, "github.com/debudda/option/...")
+}
+
+func TestNoZeroResults_detects(t *testing.T) {
+	rec := &recorder{TB: t}
+	optiontest.NoZeroResults(rec, "./testdata/zero/...")
+	want := []string{"zero.go:9:9", "zero.go:13:9", "zero.go:18:9", "zero.go:22:2"}
+	if len(rec.errs) != len(want) {
+		t.Fatalf("expected %d errors, got %q", len(want), rec.errs)
+	}
+	for i, line := range want {
+		if !strings.Contains(rec.errs[i], line) {
+			t.Errorf("expected %q to point at %s", rec.errs[i], line)
+		}
+	}
+}
+
+func TestInitialized(t *testing.T) {
+	optiontest.Initialized(t, option.Ok(1))
+	optiontest.Initialized(t, option.Err[int](option.ErrNotOK))
+
+	rec := &recorder{TB: t}
+	optiontest.Initialized(rec, option.Result[int]{})
+	if len(rec.errs) != 1 {
+		t.Fatalf("expected an error for the zero Result, got %q", rec.errs)
+	}
+}
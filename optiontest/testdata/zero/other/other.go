@@ -0,0 +1,4 @@
+package other
+
+// Result is unrelated to option.Result
+type Result struct{ N int }
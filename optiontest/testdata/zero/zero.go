@@ -0,0 +1,42 @@
+package zero
+
+import (
+	opt "github.com/debudda/option"
+	"github.com/debudda/option/optiontest/testdata/zero/other"
+)
+
+func Load() opt.Result[int] {
+	return opt.Result[int]{}
+}
+
+func Save() (opt.Result[string], error) {
+	return (opt.Result[string]{}), nil
+}
+
+func Declared() opt.Result[int] {
+	var r opt.Result[int]
+	return r
+}
+
+func Named() (r opt.Result[int]) {
+	return
+}
+
+func Fine() opt.Result[int] {
+	return opt.Ok(1)
+}
+
+func Assigned(fail bool) (r opt.Result[int]) {
+	var res opt.Result[int]
+	if fail {
+		res = opt.Err[int](opt.ErrNotOK)
+	} else {
+		res = opt.Ok(1)
+	}
+	r = res
+	return
+}
+
+func Other() other.Result {
+	return other.Result{}
+}
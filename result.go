@@ -1,6 +1,9 @@
 package option
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 type (
 	// Ok funcs
@@ -19,18 +22,45 @@ type Result[T any] struct {
 // ErrNotOK is a therapeutic default error message
 var ErrNotOK = errors.New("result is not ok, but it's ok")
 
+// ErrUninitialized is returned for the zero value of Result which is neither Ok nor Err,
+// it wraps ErrNotOK
+var ErrUninitialized = fmt.Errorf("result is uninitialized: %w", ErrNotOK)
+
+// ErrNil is returned by OkNonNil for nil values
+var ErrNil = errors.New("value is nil")
+
+// Ok constructs a successful Result, nil pointers, maps, slices, interfaces, funcs and channels are
+// valid Ok values, use OkNonNil to treat them as errors
 func Ok[T any](v T) Result[T] {
-	if &v == nil {
-		return Result[T]{e: ErrNotOK}
-	}
 	return Result[T]{t: &v}
 }
 
+// OkNonNil constructs a successful Result or Err with ErrNil if v is a nil pointer, map, slice,
+// interface, func or channel
+func OkNonNil[T any](v T) Result[T] {
+	if isNil(v) {
+		return Err[T](ErrNil)
+	}
+	return Ok(v)
+}
+
+// Err constructs a failed Result, a nil error is replaced with ErrNotOK.
+// A stack trace is captured if enabled with CaptureStackTraces
 func Err[T any](err error) Result[T] {
 	if err == nil {
-		return Result[T]{e: ErrNotOK}
+		err = ErrNotOK
 	}
-	return Result[T]{e: err}
+	return Result[T]{e: traced(err)}
+}
+
+// Try converts a (value, error) pair into a Result, usually applied directly to a call
+//
+//	res := option.Try(strconv.Atoi(s))
+func Try[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Result[T]{e: traced(err)}
+	}
+	return Ok(v)
 }
 
 func (r Result[T]) IsOk() bool {
@@ -41,6 +71,19 @@ func (r Result[T]) IsErr() bool {
 	return r.e != nil
 }
 
+// IsZero reports whether the Result is the uninitialized zero value, neither Ok nor Err
+func (r Result[T]) IsZero() bool {
+	return !r.IsOk() && !r.IsErr()
+}
+
+// err returns the error of a non Ok Result falling back to ErrUninitialized
+func (r Result[T]) err() error {
+	if r.IsErr() {
+		return r.e
+	}
+	return ErrUninitialized
+}
+
 func (r Result[T]) Switch(
 	ok OkFunc[T],
 	err ErrFunc,
@@ -63,7 +106,20 @@ func (r Result[T]) Switchv(
 	if r.IsErr() {
 		return v, err(r.e)
 	}
-	return v, ErrNotOK
+	return v, ErrUninitialized
+}
+
+// Switch3 is the same as Switch but calls zero for the uninitialized Result instead of ignoring it
+func (r Result[T]) Switch3(
+	ok OkFunc[T],
+	err ErrFunc,
+	zero func(),
+) {
+	if r.IsZero() {
+		zero()
+		return
+	}
+	r.Switch(ok, err)
 }
 
 func (r Result[T]) SwitchErr(
@@ -74,7 +130,7 @@ func (r Result[T]) SwitchErr(
 		ok(*r.t)
 		return nil
 	} else if !r.IsErr() {
-		return ErrNotOK
+		return ErrUninitialized
 	}
 	return err(r.e)
 }
@@ -115,16 +171,16 @@ func (r Result[T]) Ok(ok OkFunc[T]) {
 }
 
 func (r Result[T]) MustOk(ok OkFunc[T]) {
-	err := ErrNotOK
-	if r.IsErr() {
-		err = r.e
-	}
-	ok(r.Must(err.Error()))
+	ok(r.Must(r.err().Error()))
 }
 
+// OkErr calls ok if the Result is Ok and returns the error otherwise, ErrUninitialized for the zero value
 func (r Result[T]) OkErr(ok OkFunc[T]) error {
-	r.Ok(ok)
-	return r.e
+	if r.IsOk() {
+		ok(*r.t)
+		return nil
+	}
+	return r.err()
 }
 
 func (r Result[T]) OkPtr(ok OkFunc[*T]) {
@@ -0,0 +1,33 @@
+package option_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/debudda/option"
+)
+
+func ExampleResult_Switch3() {
+	for _, r := range []option.Result[int]{option.Ok(1), option.Err[int](errors.New("boom")), {}} {
+		r.Switch3(
+			func(n int) { fmt.Println("ok", n) },
+			func(err error) { fmt.Println("err", err) },
+			func() { fmt.Println("uninitialized") })
+	}
+	// Output: ok 1
+	// err boom
+	// uninitialized
+}
+
+func ExampleResult_IsZero() {
+	var r option.Result[int]
+	_, err := r.Switchv(
+		func(n int) int { return n },
+		func(err error) error { return err })
+	fmt.Println(r.IsZero(), errors.Is(err, option.ErrUninitialized), errors.Is(err, option.ErrNotOK))
+	fmt.Println(r.OkErr(func(int) {}))
+	fmt.Printf("%#v\n", r)
+	// Output: true true true
+	// result is uninitialized: result is not ok, but it's ok
+	// option.Result[int]{}
+}
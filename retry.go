@@ -0,0 +1,194 @@
+package option
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// DefaultMaxAttempts is used by Retry when neither MaxAttempts nor MaxElapsed is set
+const DefaultMaxAttempts = 3
+
+// Backoff returns the delay before the next attempt, attempt is the number of failed attempts so far starting at 1
+type Backoff func(attempt int) time.Duration
+
+// ConstantBackoff waits the same delay between attempts
+func ConstantBackoff(d time.Duration) Backoff {
+	return func(int) time.Duration {
+		return d
+	}
+}
+
+// ExponentialBackoff doubles the delay after every attempt starting at initial, a positive maxDelay caps the delay
+func ExponentialBackoff(initial, maxDelay time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		d := initial
+		for i := 1; i < attempt && d < limit(maxDelay); i++ {
+			d *= 2
+		}
+		return capDelay(d, maxDelay)
+	}
+}
+
+// FibonacciBackoff grows the delay following the Fibonacci sequence starting at initial, a positive maxDelay caps the delay
+func FibonacciBackoff(initial, maxDelay time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		prev, d := time.Duration(0), initial
+		for i := 1; i < attempt && d < limit(maxDelay); i++ {
+			prev, d = d, prev+d
+		}
+		return capDelay(d, maxDelay)
+	}
+}
+
+// limit returns the delay at which a backoff stops growing, without a cap it stops before overflowing
+func limit(maxDelay time.Duration) time.Duration {
+	if maxDelay > 0 {
+		return maxDelay
+	}
+	return math.MaxInt64 / 2
+}
+
+func capDelay(d, maxDelay time.Duration) time.Duration {
+	if maxDelay > 0 && d > maxDelay {
+		return maxDelay
+	}
+	return d
+}
+
+// RetryClock provides the time to Retry, it can be replaced in tests.
+// It extends cache.Clock with After, so a single fake clock can serve both
+type RetryClock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// RetryAttempt describes a finished attempt of Retry
+type RetryAttempt struct {
+	// Number of the attempt starting at 1
+	Number int
+	// Err is nil if the attempt succeeded
+	Err error
+	// Delay before the next attempt, only meaningful if Retrying is true
+	Delay    time.Duration
+	Retrying bool
+}
+
+// RetryPolicy configures Retry
+type RetryPolicy struct {
+	// Backoff defaults to no delay
+	Backoff Backoff
+	// Jitter randomly shortens each delay by up to the given fraction, between 0 and 1
+	Jitter float64
+	// Rand returns a number in [0, 1) used to apply Jitter, defaults to math/rand/v2 Float64
+	Rand func() float64
+	// MaxAttempts limits the number of attempts, zero means no limit
+	MaxAttempts int
+	// MaxElapsed stops retrying when the next attempt would start later than MaxElapsed after the first one, zero means no limit
+	MaxElapsed time.Duration
+	// Retryable classifies errors, all errors are retried if nil. Errors wrapped with Permanent are never retried
+	Retryable func(err error) bool
+	// OnAttempt is called after every attempt
+	OnAttempt func(a RetryAttempt)
+	// Clock defaults to the system clock
+	Clock RetryClock
+}
+
+// PermanentError stops Retry. When fn returns it directly it is unwrapped before being returned,
+// when it is wrapped the error is returned as is to keep its context, PermanentError doesn't change the message
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// Permanent wraps err so that Retry returns it without further attempts, it returns nil if err is nil
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &PermanentError{Err: err}
+}
+
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	var d time.Duration
+	if p.Backoff != nil {
+		d = p.Backoff(attempt)
+	}
+	if p.Jitter > 0 {
+		random := p.Rand
+		if random == nil {
+			random = rand.Float64
+		}
+		d -= time.Duration(float64(d) * min(p.Jitter, 1) * random())
+	}
+	return d
+}
+
+// Retry calls fn until it returns Ok, the error is not retryable or the policy is exhausted, the last Result is returned.
+// ctx is checked before every attempt, once it is done fn is not called again and the cause of cancellation
+// is joined with the last error
+func Retry[T any](ctx context.Context, policy RetryPolicy, fn ResultCtxFunc[T]) Result[T] {
+	if policy.Clock == nil {
+		policy.Clock = systemClock{}
+	}
+	if policy.MaxAttempts <= 0 && policy.MaxElapsed <= 0 {
+		policy.MaxAttempts = DefaultMaxAttempts
+	}
+	start := policy.Clock.Now()
+	var last error
+	for attempt := 1; ; attempt++ {
+		if ctx.Err() != nil {
+			return Err[T](errors.Join(context.Cause(ctx), last))
+		}
+		res := fn(ctx)
+		a := RetryAttempt{Number: attempt}
+		if !res.IsOk() {
+			a.Err = res.err()
+		}
+		last = a.Err
+		var permanent *PermanentError
+		switch {
+		case a.Err == nil:
+		case errors.As(a.Err, &permanent):
+			// only a PermanentError returned as is is removed, a wrapping error keeps its context
+			if permanent == untraced(a.Err) {
+				res = Err[T](permanent.Err)
+			}
+		case policy.Retryable != nil && !policy.Retryable(a.Err):
+		case policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts:
+		default:
+			a.Delay = policy.delay(attempt)
+			a.Retrying = policy.MaxElapsed <= 0 || policy.Clock.Now().Add(a.Delay).Sub(start) <= policy.MaxElapsed
+		}
+		if policy.OnAttempt != nil {
+			policy.OnAttempt(a)
+		}
+		if !a.Retrying {
+			return res
+		}
+		select {
+		case <-ctx.Done():
+			return Err[T](errors.Join(context.Cause(ctx), a.Err))
+		case <-policy.Clock.After(a.Delay):
+		}
+	}
+}
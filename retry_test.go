@@ -0,0 +1,186 @@
+package option_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/debudda/option"
+)
+
+// fakeClock advances instantly when waited on
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+var errUnavailable = errors.New("unavailable")
+
+// flaky fails the given number of times before succeeding
+func flaky(failures int) (option.ResultCtxFunc[string], *int) {
+	calls := 0
+	return func(ctx context.Context) option.Result[string] {
+		calls++
+		if calls <= failures {
+			return option.Err[string](errUnavailable)
+		}
+		return option.Ok("done")
+	}, &calls
+}
+
+func ExampleRetry() {
+	fn, calls := flaky(2)
+	res := option.Retry(context.Background(), option.RetryPolicy{
+		Backoff:     option.ExponentialBackoff(100*time.Millisecond, time.Second),
+		MaxAttempts: 5,
+		Clock:       &fakeClock{},
+		OnAttempt: func(a option.RetryAttempt) {
+			fmt.Println(a.Number, a.Err, a.Delay, a.Retrying)
+		},
+	}, fn)
+	fmt.Println(res, *calls)
+	// Output: 1 unavailable 100ms true
+	// 2 unavailable 200ms true
+	// 3 <nil> 0s false
+	// Ok(done) 3
+}
+
+func ExampleRetry_exhausted() {
+	fn, calls := flaky(10)
+	fmt.Println(option.Retry(context.Background(), option.RetryPolicy{Clock: &fakeClock{}}, fn), *calls)
+	// Output: Err(unavailable) 3
+}
+
+func ExamplePermanent() {
+	calls := 0
+	res := option.Retry(context.Background(), option.RetryPolicy{MaxAttempts: 5}, func(ctx context.Context) option.Result[int] {
+		calls++
+		return option.Err[int](option.Permanent(errors.New("bad request")))
+	})
+	fmt.Println(res, calls)
+
+	res = option.Retry(context.Background(), option.RetryPolicy{MaxAttempts: 5}, func(ctx context.Context) option.Result[int] {
+		return option.Err[int](fmt.Errorf("create user: %w", option.Permanent(errors.New("bad request"))))
+	})
+	fmt.Println(res, option.Permanent(nil) == nil)
+	// Output: Err(bad request) 1
+	// Err(create user: bad request) true
+}
+
+func ExampleBackoff() {
+	exp := option.ExponentialBackoff(time.Second, 10*time.Second)
+	fib := option.FibonacciBackoff(time.Second, 0)
+	for attempt := 1; attempt <= 6; attempt++ {
+		fmt.Println(exp(attempt), fib(attempt), option.ConstantBackoff(time.Second)(attempt))
+	}
+	// Output: 1s 1s 1s
+	// 2s 1s 1s
+	// 4s 2s 1s
+	// 8s 3s 1s
+	// 10s 5s 1s
+	// 10s 8s 1s
+}
+
+func TestRetry_retryable(t *testing.T) {
+	fn, calls := flaky(10)
+	res := option.Retry(context.Background(), option.RetryPolicy{
+		MaxAttempts: 10,
+		Retryable:   func(err error) bool { return !errors.Is(err, errUnavailable) },
+	}, fn)
+	if !option.ContainsErr(res, errUnavailable) || *calls != 1 {
+		t.Fatalf("expected a single attempt, got %v after %d calls", res, *calls)
+	}
+}
+
+func TestRetry_maxElapsed(t *testing.T) {
+	clock := &fakeClock{}
+	fn, calls := flaky(100)
+	res := option.Retry(context.Background(), option.RetryPolicy{
+		Backoff:    option.ConstantBackoff(time.Second),
+		MaxElapsed: 5 * time.Second,
+		Clock:      clock,
+	}, fn)
+	if !option.ContainsErr(res, errUnavailable) || *calls != 6 || clock.Now().Sub(time.Time{}) != 5*time.Second {
+		t.Fatalf("expected 6 attempts within 5s, got %v after %d calls and %s", res, *calls, clock.Now().Sub(time.Time{}))
+	}
+}
+
+func TestRetry_jitter(t *testing.T) {
+	var delays []time.Duration
+	fn, _ := flaky(100)
+	random := []float64{0, 0.5, 0.25, 0.999}
+	option.Retry(context.Background(), option.RetryPolicy{
+		Backoff:     option.ConstantBackoff(time.Second),
+		Jitter:      0.5,
+		MaxAttempts: len(random) + 1,
+		Clock:       &fakeClock{},
+		Rand:        func() float64 { return random[len(delays)] },
+		OnAttempt: func(a option.RetryAttempt) {
+			if a.Retrying {
+				delays = append(delays, a.Delay)
+			}
+		},
+	}, fn)
+	want := []time.Duration{time.Second, 750 * time.Millisecond, 875 * time.Millisecond, 500500 * time.Microsecond}
+	if !slices.Equal(delays, want) {
+		t.Fatalf("expected delays %v, got %v", want, delays)
+	}
+}
+
+func TestRetry_cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fn, calls := flaky(100)
+	res := option.Retry(ctx, option.RetryPolicy{
+		Backoff:     option.ConstantBackoff(time.Hour),
+		MaxAttempts: 10,
+		OnAttempt:   func(option.RetryAttempt) { cancel() },
+	}, fn)
+	if !option.ContainsErr(res, context.Canceled) || !option.ContainsErr(res, errUnavailable) || *calls != 1 {
+		t.Fatalf("expected cancellation after the first attempt, got %v after %d calls", res, *calls)
+	}
+}
+
+func TestRetry_cancelWithoutDelay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	fn, calls := flaky(100)
+	res := option.Retry(ctx, option.RetryPolicy{
+		MaxAttempts: 10,
+		OnAttempt:   func(option.RetryAttempt) { cancel() },
+	}, fn)
+	if !option.ContainsErr(res, context.Canceled) || !option.ContainsErr(res, errUnavailable) || *calls != 1 {
+		t.Fatalf("expected cancellation after the first attempt, got %v after %d calls", res, *calls)
+	}
+
+	res = option.Retry(ctx, option.RetryPolicy{}, fn)
+	if !option.ContainsErr(res, context.Canceled) || *calls != 1 {
+		t.Fatalf("expected no attempt with a done context, got %v after %d calls", res, *calls)
+	}
+}
+
+func TestBackoff_overflow(t *testing.T) {
+	if d := option.ExponentialBackoff(time.Second, 0)(1000); d <= 0 {
+		t.Fatalf("expected a positive delay, got %s", d)
+	}
+	if d := option.FibonacciBackoff(time.Second, 0)(1000); d <= 0 {
+		t.Fatalf("expected a positive delay, got %s", d)
+	}
+}
@@ -0,0 +1,81 @@
+package option
+
+// Find returns the first Some value matching a callback condition, stops at the first match
+func (opts Options[T]) Find(fn FilterFunc[T]) Option[T] {
+	for _, opt := range opts {
+		if opt.IsSome() && fn(*opt.some) {
+			return opt
+		}
+	}
+	return O[T]()
+}
+
+// FindIndex returns the index of the first Some value matching a callback condition, stops at the first match
+func (opts Options[T]) FindIndex(fn FilterFunc[T]) Option[int] {
+	for i, opt := range opts {
+		if opt.IsSome() && fn(*opt.some) {
+			return O(i)
+		}
+	}
+	return O[int]()
+}
+
+// FindLast returns the last Some value matching a callback condition, goes from right to left and stops at the first match
+func (opts Options[T]) FindLast(fn FilterFunc[T]) Option[T] {
+	for i := len(opts) - 1; i >= 0; i-- {
+		if opts[i].IsSome() && fn(*opts[i].some) {
+			return opts[i]
+		}
+	}
+	return O[T]()
+}
+
+// First returns the first Some value
+func (opts Options[T]) First() Option[T] {
+	return opts.Find(func(T) bool { return true })
+}
+
+// Last returns the last Some value
+func (opts Options[T]) Last() Option[T] {
+	return opts.FindLast(func(T) bool { return true })
+}
+
+// Nth returns the element at index i, None if i is out of range or the element is None
+func (opts Options[T]) Nth(i int) Option[T] {
+	return At(opts, i).Default(O[T]())
+}
+
+// Any reports whether any Some value matches a callback condition, stops at the first match
+func (opts Options[T]) Any(fn FilterFunc[T]) bool {
+	return opts.FindIndex(fn).IsSome()
+}
+
+// All reports whether every Some value matches a callback condition, stops at the first mismatch
+func (opts Options[T]) All(fn FilterFunc[T]) bool {
+	return opts.FindIndex(func(some T) bool { return !fn(some) }).IsNone()
+}
+
+// None reports whether no Some value matches a callback condition, stops at the first match
+func (opts Options[T]) None(fn FilterFunc[T]) bool {
+	return !opts.Any(fn)
+}
+
+// CountSome returns the number of Some values
+func (opts Options[T]) CountSome() int {
+	return Foldl(opts, func(n int, _ T) int { return n + 1 }, 0)
+}
+
+// CountNone returns the number of None values
+func (opts Options[T]) CountNone() int {
+	return len(opts) - opts.CountSome()
+}
+
+// IndexesOfNone returns the indexes of None values
+func (opts Options[T]) IndexesOfNone() (res []int) {
+	for i, opt := range opts {
+		if opt.IsNone() {
+			res = append(res, i)
+		}
+	}
+	return
+}
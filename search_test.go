@@ -0,0 +1,51 @@
+package option_test
+
+import (
+	"fmt"
+
+	"github.com/debudda/option"
+)
+
+func ExampleOptions_Find() {
+	alive := func(w Writer) bool { return w.Alive }
+	fmt.Println(options.Find(alive).Default(Writer{}).Name)
+	fmt.Println(options.FindLast(alive).Default(Writer{}).Name)
+	fmt.Println(options.FindIndex(alive), options.FindIndex(func(w Writer) bool { return w.Age > 100 }))
+	// Output: Neil Gaiman
+	// Neal Stephenson
+	// Some(2) None
+}
+
+func ExampleOptions_Find_shortCircuit() {
+	calls := 0
+	option.Slice(1, 2, 3, 4).Find(func(n int) bool {
+		calls++
+		return n == 2
+	})
+	fmt.Println(calls)
+	// Output: 2
+}
+
+func ExampleOptions_First() {
+	opts := option.Options[int]{option.O[int](), option.O(1), option.O(2), option.O[int]()}
+	fmt.Println(opts.First(), opts.Last(), option.Options[int]{}.First())
+	// Output: Some(1) Some(2) None
+}
+
+func ExampleOptions_Nth() {
+	fmt.Println(options.Nth(0).IsSome(), options.Nth(1), options.Nth(10), options.Nth(-1))
+	// Output: true None None None
+}
+
+func ExampleOptions_Any() {
+	old := func(w Writer) bool { return w.Age > 60 }
+	fmt.Println(options.Any(old), options.All(old), options.None(old))
+	fmt.Println(options.All(func(w Writer) bool { return w.Age > 40 }))
+	// Output: true false false
+	// true
+}
+
+func ExampleOptions_CountSome() {
+	fmt.Println(options.CountSome(), options.CountNone(), options.IndexesOfNone())
+	// Output: 3 1 [1]
+}
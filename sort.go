@@ -0,0 +1,108 @@
+package option
+
+import (
+	"cmp"
+	"slices"
+)
+
+// NonePlacement defines what happens to None values when sorting
+type NonePlacement int
+
+const (
+	// PlaceNoneFirst puts None values before Some values
+	PlaceNoneFirst NonePlacement = iota
+	// PlaceNoneLast puts None values after Some values
+	PlaceNoneLast
+	// DropNone removes None values
+	DropNone
+)
+
+func sortBy[T any, K cmp.Ordered](opts Options[T], key func(some T) K, none NonePlacement, sort func(Options[T], func(a, b Option[T]) int)) Options[T] {
+	res := slices.Clone(opts)
+	if none == DropNone {
+		res = slices.DeleteFunc(res, Option[T].IsNone)
+	}
+	byKey := func(a, b Option[T]) int {
+		return CompareFunc(a, b, func(a, b T) int {
+			return cmp.Compare(key(a), key(b))
+		})
+	}
+	if none == PlaceNoneLast {
+		byKey = NoneLast(byKey)
+	}
+	sort(res, byKey)
+	return res
+}
+
+// SortBy returns a copy of Options sorted by a key produced by a callback, None values are placed according to none
+func SortBy[T any, K cmp.Ordered](opts Options[T], key func(some T) K, none NonePlacement) Options[T] {
+	return sortBy(opts, key, none, slices.SortFunc[Options[T]])
+}
+
+// SortStableBy is the same as SortBy but keeps the original order of values with equal keys
+func SortStableBy[T any, K cmp.Ordered](opts Options[T], key func(some T) K, none NonePlacement) Options[T] {
+	return sortBy(opts, key, none, slices.SortStableFunc[Options[T]])
+}
+
+// keyOf returns the Key of a value produced by a callback, the None Key for None
+func keyOf[T any, K comparable](opt Option[T], key func(some T) K) Key[K] {
+	if opt.IsNone() {
+		return Key[K]{}
+	}
+	return Key[K]{Value: key(*opt.some), Some: true}
+}
+
+// GroupBy groups Some values by a key produced by a callback keeping their order, None values are dropped
+func GroupBy[T any, K comparable](opts Options[T], key func(some T) K) map[K]Options[T] {
+	return Foldl(opts, func(res map[K]Options[T], next T) map[K]Options[T] {
+		k := key(next)
+		res[k] = res[k].Append(next)
+		return res
+	}, map[K]Options[T]{})
+}
+
+// GroupByOption is the same as GroupBy but None values are grouped under the None Key
+func GroupByOption[T any, K comparable](opts Options[T], key func(some T) K) map[Key[K]]Options[T] {
+	res := map[Key[K]]Options[T]{}
+	for _, opt := range opts {
+		k := keyOf(opt, key)
+		res[k] = append(res[k], opt)
+	}
+	return res
+}
+
+// CountBy counts Some values by a key produced by a callback, None values are not counted
+func CountBy[T any, K comparable](opts Options[T], key func(some T) K) map[K]int {
+	return Foldl(opts, func(res map[K]int, next T) map[K]int {
+		res[key(next)]++
+		return res
+	}, map[K]int{})
+}
+
+// Distinct returns Options without duplicates keeping the first occurrence, None is kept once as well
+func Distinct[T comparable](opts Options[T]) Options[T] {
+	return DistinctBy(opts, func(some T) T { return some })
+}
+
+// DistinctBy returns Options without values with duplicate keys keeping the first occurrence, None is kept once as well
+func DistinctBy[T any, K comparable](opts Options[T], key func(some T) K) Options[T] {
+	seen := map[Key[K]]struct{}{}
+	res := Options[T]{}
+	for _, opt := range opts {
+		k := keyOf(opt, key)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		res = append(res, opt)
+	}
+	return res
+}
+
+// KeyBy builds a map of Some values by a key produced by a callback, later values win, None values are dropped
+func KeyBy[T any, K comparable](opts Options[T], key func(some T) K) map[K]T {
+	return Foldl(opts, func(res map[K]T, next T) map[K]T {
+		res[key(next)] = next
+		return res
+	}, map[K]T{})
+}
@@ -0,0 +1,62 @@
+package option_test
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/debudda/option"
+)
+
+func names(opts option.Options[Writer]) option.Options[string] {
+	return option.MapO(opts, func(w Writer) string {
+		return w.Name
+	})
+}
+
+func ExampleSortBy() {
+	byName := func(w Writer) string { return w.Name }
+	fmt.Println(names(option.SortBy(options, byName, option.PlaceNoneFirst)))
+	fmt.Println(names(option.SortBy(options, byName, option.PlaceNoneLast)))
+	fmt.Println(names(option.SortBy(options, byName, option.DropNone)))
+	fmt.Println(names(options))
+	// Output: [None Some(Douglas Adams) Some(Neal Stephenson) Some(Neil Gaiman)]
+	// [Some(Douglas Adams) Some(Neal Stephenson) Some(Neil Gaiman) None]
+	// [Some(Douglas Adams) Some(Neal Stephenson) Some(Neil Gaiman)]
+	// [Some(Douglas Adams) None Some(Neil Gaiman) Some(Neal Stephenson)]
+}
+
+func ExampleSortStableBy() {
+	words := option.Options[string]{option.O("bb"), option.O("a"), option.O[string](), option.O("cc"), option.O("d")}
+	fmt.Println(option.SortStableBy(words, func(s string) int { return len(s) }, option.PlaceNoneLast))
+	// Output: [Some(a) Some(d) Some(bb) Some(cc) None]
+}
+
+func ExampleGroupBy() {
+	groups := option.GroupBy(options, func(w Writer) bool { return w.Alive })
+	fmt.Println(names(groups[true]), names(groups[false]))
+
+	withNone := option.GroupByOption(options, func(w Writer) bool { return w.Alive })
+	fmt.Println(len(withNone[option.Key[bool]{}]), len(withNone[option.KeyOf(option.O(true))]))
+	// Output: [Some(Neil Gaiman) Some(Neal Stephenson)] [Some(Douglas Adams)]
+	// 1 2
+}
+
+func ExampleCountBy() {
+	fmt.Println(option.CountBy(options, func(w Writer) bool { return w.Alive }))
+	// Output: map[false:1 true:2]
+}
+
+func ExampleDistinct() {
+	opts := option.Options[int]{option.O(1), option.O[int](), option.O(2), option.O(1), option.O[int]()}
+	fmt.Println(option.Distinct(opts))
+	words := option.Slice("Go", "go", "Rust")
+	fmt.Println(option.DistinctBy(words, strings.ToLower))
+	// Output: [Some(1) None Some(2)]
+	// [Some(Go) Some(Rust)]
+}
+
+func ExampleKeyBy() {
+	byName := option.KeyBy(options, func(w Writer) string { return w.Name })
+	fmt.Println(len(byName), byName["Neil Gaiman"].Age)
+	// Output: 3 61
+}
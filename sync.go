@@ -0,0 +1,95 @@
+package option
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Atomic is an Option which can be safely shared between goroutines, the zero value is None.
+// Values are copied on Store and must not be mutated through SomePtr after Load
+type Atomic[T any] struct {
+	p atomic.Pointer[T]
+}
+
+// NewAtomic constructs an Atomic holding the provided Option
+func NewAtomic[T any](o Option[T]) *Atomic[T] {
+	a := &Atomic[T]{}
+	a.Store(o)
+	return a
+}
+
+func clone[T any](o Option[T]) *T {
+	if o.IsNone() {
+		return nil
+	}
+	v := *o.some
+	return &v
+}
+
+// Load returns the current Option
+func (a *Atomic[T]) Load() Option[T] {
+	return Option[T]{some: a.p.Load()}
+}
+
+// Store replaces the current Option
+func (a *Atomic[T]) Store(o Option[T]) {
+	a.p.Store(clone(o))
+}
+
+// Swap replaces the current Option and returns the previous one
+func (a *Atomic[T]) Swap(o Option[T]) Option[T] {
+	return Option[T]{some: a.p.Swap(clone(o))}
+}
+
+// CompareAndSwap replaces the current Option with next if it is still old.
+// Comparison is by identity, so old must be an Option returned by Load or Swap, or None
+func (a *Atomic[T]) CompareAndSwap(old, next Option[T]) bool {
+	return a.p.CompareAndSwap(old.some, clone(next))
+}
+
+// Once computes a Result at most once, the zero value is ready to use
+type Once[T any] struct {
+	once sync.Once
+	done atomic.Bool
+	res  Result[T]
+}
+
+// Do calls fn the first time it is invoked and returns its Result on every call,
+// concurrent callers block until the first call returns. If fn panics the Result is not Ok
+func (o *Once[T]) Do(fn func() Result[T]) Result[T] {
+	o.once.Do(func() {
+		defer o.done.Store(true)
+		o.res = fn()
+	})
+	return o.res
+}
+
+// Option returns the computed value, None if Do has not finished yet or the Result is not Ok
+func (o *Once[T]) Option() Option[T] {
+	if !o.done.Load() || !o.res.IsOk() {
+		return O[T]()
+	}
+	return Option[T]{some: o.res.t}
+}
+
+// Lazy is a value computed on the first access
+type Lazy[T any] struct {
+	once Once[T]
+	fn   func() Result[T]
+}
+
+// NewLazy constructs a Lazy value computed with fn
+func NewLazy[T any](fn func() Result[T]) *Lazy[T] {
+	return &Lazy[T]{fn: fn}
+}
+
+// Result computes the value if needed and returns it
+func (l *Lazy[T]) Result() Result[T] {
+	return l.once.Do(l.fn)
+}
+
+// Option computes the value if needed and returns it, None if the Result is not Ok
+func (l *Lazy[T]) Option() Option[T] {
+	l.Result()
+	return l.once.Option()
+}
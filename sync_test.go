@@ -0,0 +1,156 @@
+package option_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/debudda/option"
+)
+
+func ExampleAtomic() {
+	var leader option.Atomic[string]
+	fmt.Println(leader.Load())
+	leader.Store(option.O("node-1"))
+	prev := leader.Swap(option.O("node-2"))
+	fmt.Println(prev, leader.Load())
+	// Output: None
+	// Some(node-1) Some(node-2)
+}
+
+func ExampleAtomic_CompareAndSwap() {
+	leader := option.NewAtomic(option.O("node-1"))
+	current := leader.Load()
+	fmt.Println(leader.CompareAndSwap(current, option.O("node-2")))
+	fmt.Println(leader.CompareAndSwap(current, option.O("node-3")))
+	fmt.Println(leader.CompareAndSwap(option.O("node-2"), option.O("node-3")))
+	fmt.Println(leader.Load())
+	// Output: true
+	// false
+	// false
+	// Some(node-2)
+}
+
+func ExampleOnce() {
+	var token option.Once[string]
+	fmt.Println(token.Option())
+	for i := 0; i < 3; i++ {
+		token.Do(func() option.Result[string] {
+			fmt.Println("fetching token")
+			return option.Ok("secret")
+		})
+	}
+	fmt.Println(token.Option())
+	// Output: None
+	// fetching token
+	// Some(secret)
+}
+
+func ExampleLazy() {
+	config := option.NewLazy(func() option.Result[int] {
+		fmt.Println("loading")
+		return option.Err[int](errors.New("no config"))
+	})
+	fmt.Println(config.Option())
+	fmt.Println(config.Result())
+	// Output: loading
+	// None
+	// Err(no config)
+}
+
+func TestAtomic_concurrent(t *testing.T) {
+	var (
+		a       option.Atomic[int]
+		wg      sync.WaitGroup
+		swapped atomic.Int64
+	)
+	const workers, rounds = 8, 1000
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < rounds; i++ {
+				for {
+					cur := a.Load()
+					if a.CompareAndSwap(cur, option.O(cur.Default(0)+1)) {
+						swapped.Add(1)
+						break
+					}
+				}
+				a.Load().Some(func(int) {})
+			}
+		}()
+	}
+	wg.Wait()
+	if got := a.Load().Default(0); got != workers*rounds {
+		t.Fatalf("expected %d, got %d", workers*rounds, got)
+	}
+	if swapped.Load() != workers*rounds {
+		t.Fatalf("expected %d swaps, got %d", workers*rounds, swapped.Load())
+	}
+	if prev := a.Swap(option.O[int]()); prev.Default(0) != workers*rounds || a.Load().IsSome() {
+		t.Fatalf("unexpected swap result %v, %v", prev, a.Load())
+	}
+}
+
+func TestOnce_concurrent(t *testing.T) {
+	var (
+		once  option.Once[int]
+		calls atomic.Int64
+		wg    sync.WaitGroup
+	)
+	for w := 0; w < 64; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			once.Option()
+			res := once.Do(func() option.Result[int] {
+				calls.Add(1)
+				return option.Ok(42)
+			})
+			if res.Default(0) != 42 || once.Option().Default(0) != 42 {
+				t.Errorf("unexpected result %v", res)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Fatalf("expected a single call, got %d", calls.Load())
+	}
+}
+
+func TestLazy_concurrent(t *testing.T) {
+	var calls atomic.Int64
+	lazy := option.NewLazy(func() option.Result[string] {
+		calls.Add(1)
+		return option.Ok("value")
+	})
+	var wg sync.WaitGroup
+	for w := 0; w < 64; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v := lazy.Option().Default(""); v != "value" {
+				t.Errorf("unexpected value %q", v)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Fatalf("expected a single call, got %d", calls.Load())
+	}
+}
+
+func TestOnce_panic(t *testing.T) {
+	var once option.Once[int]
+	func() {
+		defer func() { recover() }()
+		once.Do(func() option.Result[int] { panic("boom") })
+	}()
+	res := once.Do(func() option.Result[int] { return option.Ok(1) })
+	if res.IsOk() || once.Option().IsSome() {
+		t.Fatalf("expected a failed result after panic, got %v", res)
+	}
+}
@@ -0,0 +1,135 @@
+package option
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+)
+
+// maxStackDepth limits the number of captured frames
+const maxStackDepth = 32
+
+var captureStacks atomic.Bool
+
+// CaptureStackTraces enables or disables capturing stack traces in Err, Try and With.
+// Capturing is disabled by default, it costs an allocation and a stack walk per error
+func CaptureStackTraces(enabled bool) {
+	captureStacks.Store(enabled)
+}
+
+// TracedError is an error carrying the stack where it entered a Result and key-value fields,
+// it can be extracted from a Result error with errors.As
+type TracedError struct {
+	Err    error
+	Fields []slog.Attr
+	stack  []uintptr
+}
+
+// traced wraps err with a stack trace of the caller of the calling function if capturing is enabled
+// and err does not carry one yet
+func traced(err error) error {
+	if !captureStacks.Load() {
+		return err
+	}
+	var te *TracedError
+	if errors.As(err, &te) && te.stack != nil {
+		return err
+	}
+	if te, ok := err.(*TracedError); ok {
+		withStack := *te
+		withStack.stack = callers(4)
+		return &withStack
+	}
+	return &TracedError{Err: err, stack: callers(4)}
+}
+
+func callers(skip int) []uintptr {
+	pcs := make([]uintptr, maxStackDepth)
+	return pcs[:runtime.Callers(skip, pcs)]
+}
+
+// untraced returns the error wrapped by a TracedError or err itself
+func untraced(err error) error {
+	if te, ok := err.(*TracedError); ok {
+		return te.Err
+	}
+	return err
+}
+
+func (e *TracedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TracedError) Unwrap() error {
+	return e.Err
+}
+
+// Frames returns the captured stack, empty if capturing was disabled
+func (e *TracedError) Frames() []runtime.Frame {
+	if len(e.stack) == 0 {
+		return nil
+	}
+	var (
+		res    []runtime.Frame
+		frames = runtime.CallersFrames(e.stack)
+	)
+	for {
+		frame, more := frames.Next()
+		res = append(res, frame)
+		if !more {
+			return res
+		}
+	}
+}
+
+// Format implements fmt.Formatter, %+v prints the message followed by the fields and the stack trace
+func (e *TracedError) Format(f fmt.State, verb rune) {
+	if verb != 'v' || !f.Flag('+') {
+		fmt.Fprintf(f, fmt.FormatString(f, verb), e.Err)
+		return
+	}
+	fmt.Fprintf(f, "%+v", e.Err)
+	for _, attr := range e.Fields {
+		fmt.Fprintf(f, " %s=%v", attr.Key, attr.Value)
+	}
+	for _, frame := range e.Frames() {
+		io.WriteString(f, "\n"+frame.Function+"\n\t"+frame.File+":"+strconv.Itoa(frame.Line))
+	}
+}
+
+// LogValue implements slog.LogValuer, the error is logged as a group with the message, the fields and the stack
+func (e *TracedError) LogValue() slog.Value {
+	attrs := append([]slog.Attr{slog.String("msg", e.Err.Error())}, e.Fields...)
+	if frames := e.Frames(); len(frames) > 0 {
+		stack := make([]string, len(frames))
+		for i, frame := range frames {
+			stack[i] = frame.Function + " " + frame.File + ":" + strconv.Itoa(frame.Line)
+		}
+		attrs = append(attrs, slog.Any("stack", stack))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// With attaches a key-value field to the error of the Result, Ok and uninitialized Results are returned unchanged
+func (r Result[T]) With(key string, value any) Result[T] {
+	if r.IsOk() || r.IsZero() {
+		return r
+	}
+	err := r.err()
+	te, ok := err.(*TracedError)
+	if !ok {
+		te = &TracedError{Err: err}
+		if captureStacks.Load() {
+			te.stack = callers(3)
+		}
+	}
+	return Result[T]{e: &TracedError{
+		Err:    te.Err,
+		Fields: append(te.Fields[:len(te.Fields):len(te.Fields)], slog.Any(key, value)),
+		stack:  te.stack,
+	}}
+}
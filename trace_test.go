@@ -0,0 +1,107 @@
+package option_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/debudda/option"
+)
+
+var errNotFound = errors.New("not found")
+
+func findUser(id int) option.Result[User] {
+	return option.Err[User](errNotFound)
+}
+
+func parseID(s string) option.Result[int] {
+	return option.Try(strconv.Atoi(s))
+}
+
+func ExampleResult_With() {
+	err := findUser(7).With("user_id", 7).With("tenant", "acme").OkErr(func(User) {})
+	var te *option.TracedError
+	fmt.Println(errors.As(err, &te), errors.Is(err, errNotFound))
+	fmt.Println(te.Fields)
+	fmt.Printf("%v\n", err)
+	fmt.Printf("%+v\n", err)
+	fmt.Println(option.Ok(1).With("k", 1), option.Result[int]{}.With("k", 1).IsZero())
+	// Output: true true
+	// [user_id=7 tenant=acme]
+	// not found
+	// not found user_id=7 tenant=acme
+	// Ok(1) true
+}
+
+func tracedError(t *testing.T, err error) *option.TracedError {
+	t.Helper()
+	var te *option.TracedError
+	if !errors.As(err, &te) {
+		t.Fatalf("expected a TracedError, got %T", err)
+	}
+	return te
+}
+
+func TestCaptureStackTraces(t *testing.T) {
+	option.CaptureStackTraces(true)
+	defer option.CaptureStackTraces(false)
+
+	cases := map[string]error{
+		"findUser": findUser(1).OkErr(func(User) {}),
+		"parseID":  parseID("x").OkErr(func(int) {}),
+	}
+	for fn, err := range cases {
+		frames := tracedError(t, err).Frames()
+		if len(frames) == 0 || !strings.HasSuffix(frames[0].Function, "."+fn) {
+			t.Fatalf("expected the stack to start at %s, got %v", fn, frames)
+		}
+	}
+
+	err := findUser(1).With("user_id", 1).OkErr(func(User) {})
+	if frames := tracedError(t, err).Frames(); !strings.HasSuffix(frames[0].Function, ".findUser") {
+		t.Fatalf("With must keep the original stack, got %s", frames[0].Function)
+	}
+	verbose := fmt.Sprintf("%+v", err)
+	if !strings.HasPrefix(verbose, "not found user_id=1\n") || !strings.Contains(verbose, "trace_test.go") {
+		t.Fatalf("unexpected %%+v output: %s", verbose)
+	}
+	if plain := fmt.Sprint(err); plain != "not found" {
+		t.Fatalf("unexpected %%v output: %s", plain)
+	}
+	if !option.EqualResult(findUser(1), findUser(2)) {
+		t.Fatal("stack traces must be ignored by EqualResult")
+	}
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("lookup", "user", findUser(1).With("user_id", 1))
+	var entry struct {
+		User struct {
+			Error struct {
+				Msg    string   `json:"msg"`
+				UserID int      `json:"user_id"`
+				Stack  []string `json:"stack"`
+			} `json:"error"`
+		} `json:"user"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if e := entry.User.Error; e.Msg != "not found" || e.UserID != 1 || len(e.Stack) == 0 || !strings.Contains(e.Stack[0], "findUser") {
+		t.Fatalf("unexpected log entry %s", buf.String())
+	}
+}
+
+func TestCaptureStackTraces_disabled(t *testing.T) {
+	if err := findUser(1).OkErr(func(User) {}); err != errNotFound {
+		t.Fatalf("expected the plain error without capturing, got %#v", err)
+	}
+	err := findUser(1).With("user_id", 1).OkErr(func(User) {})
+	if frames := tracedError(t, err).Frames(); len(frames) != 0 {
+		t.Fatalf("expected no stack, got %v", frames)
+	}
+}
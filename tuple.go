@@ -0,0 +1,144 @@
+package option
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Pair holds two values, it is encoded as a JSON array [first, second]
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple holds three values, it is encoded as a JSON array [first, second, third]
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+func (p Pair[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{p.First, p.Second})
+}
+
+func (p *Pair[A, B]) UnmarshalJSON(bytes []byte) error {
+	return unmarshalTuple(bytes, &p.First, &p.Second)
+}
+
+func (t Triple[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third})
+}
+
+func (t *Triple[A, B, C]) UnmarshalJSON(bytes []byte) error {
+	return unmarshalTuple(bytes, &t.First, &t.Second, &t.Third)
+}
+
+func unmarshalTuple(bytes []byte, dst ...any) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(bytes, &raw); err != nil {
+		return err
+	}
+	if len(raw) != len(dst) {
+		return fmt.Errorf("expected a JSON array of %d elements, got %d", len(dst), len(raw))
+	}
+	for i, r := range raw {
+		if err := json.Unmarshal(r, dst[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Zip combines two Options into an Option of Pair, None if any of them is None
+func Zip[A, B any](a Option[A], b Option[B]) Option[Pair[A, B]] {
+	return ZipWith(a, b, func(a A, b B) Pair[A, B] {
+		return Pair[A, B]{First: a, Second: b}
+	})
+}
+
+// Zip3 combines three Options into an Option of Triple, None if any of them is None
+func Zip3[A, B, C any](a Option[A], b Option[B], c Option[C]) Option[Triple[A, B, C]] {
+	if a.IsNone() || b.IsNone() || c.IsNone() {
+		return O[Triple[A, B, C]]()
+	}
+	return O(Triple[A, B, C]{First: *a.some, Second: *b.some, Third: *c.some})
+}
+
+// ZipWith combines two Options with fn, None if any of them is None
+func ZipWith[A, B, R any](a Option[A], b Option[B], fn func(a A, b B) R) Option[R] {
+	if a.IsNone() || b.IsNone() {
+		return O[R]()
+	}
+	return O(fn(*a.some, *b.some))
+}
+
+// Unzip splits an Option of Pair into two Options
+func Unzip[A, B any](o Option[Pair[A, B]]) (Option[A], Option[B]) {
+	if o.IsNone() {
+		return O[A](), O[B]()
+	}
+	return O(o.some.First), O(o.some.Second)
+}
+
+// ZipResult combines two Results into a Result of Pair, the first error wins
+func ZipResult[A, B any](a Result[A], b Result[B]) Result[Pair[A, B]] {
+	return ZipResultWith(a, b, func(a A, b B) Pair[A, B] {
+		return Pair[A, B]{First: a, Second: b}
+	})
+}
+
+// ZipResultAll combines two Results into a Result of Pair, errors of both are joined
+func ZipResultAll[A, B any](a Result[A], b Result[B]) Result[Pair[A, B]] {
+	if errs := resultErrors(a, b); len(errs) > 0 {
+		return Err[Pair[A, B]](errors.Join(errs...))
+	}
+	return Ok(Pair[A, B]{First: *a.t, Second: *b.t})
+}
+
+// ZipResult3 combines three Results into a Result of Triple, the first error wins
+func ZipResult3[A, B, C any](a Result[A], b Result[B], c Result[C]) Result[Triple[A, B, C]] {
+	if errs := resultErrors(a, b, c); len(errs) > 0 {
+		return Err[Triple[A, B, C]](errs[0])
+	}
+	return Ok(Triple[A, B, C]{First: *a.t, Second: *b.t, Third: *c.t})
+}
+
+// ZipResult3All combines three Results into a Result of Triple, errors of all of them are joined
+func ZipResult3All[A, B, C any](a Result[A], b Result[B], c Result[C]) Result[Triple[A, B, C]] {
+	if errs := resultErrors(a, b, c); len(errs) > 0 {
+		return Err[Triple[A, B, C]](errors.Join(errs...))
+	}
+	return Ok(Triple[A, B, C]{First: *a.t, Second: *b.t, Third: *c.t})
+}
+
+// ZipResultWith combines two Results with fn, the first error wins
+func ZipResultWith[A, B, R any](a Result[A], b Result[B], fn func(a A, b B) R) Result[R] {
+	if errs := resultErrors(a, b); len(errs) > 0 {
+		return Err[R](errs[0])
+	}
+	return Ok(fn(*a.t, *b.t))
+}
+
+// UnzipResult splits a Result of Pair into two Results sharing the error
+func UnzipResult[A, B any](r Result[Pair[A, B]]) (Result[A], Result[B]) {
+	if !r.IsOk() {
+		return Err[A](r.err()), Err[B](r.err())
+	}
+	return Ok(r.t.First), Ok(r.t.Second)
+}
+
+type erroneous interface {
+	IsOk() bool
+	err() error
+}
+
+func resultErrors(rs ...erroneous) (errs []error) {
+	for _, r := range rs {
+		if !r.IsOk() {
+			errs = append(errs, r.err())
+		}
+	}
+	return
+}
@@ -0,0 +1,62 @@
+package option_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/debudda/option"
+)
+
+func ExampleZip() {
+	fmt.Println(option.Zip(option.O("age"), option.O(42)))
+	fmt.Println(option.Zip(option.O("age"), option.O[int]()))
+	fmt.Println(option.Zip3(option.O(1), option.O("two"), option.O(3.0)))
+	// Output: Some({age 42})
+	// None
+	// Some({1 two 3})
+}
+
+func ExampleZipWith() {
+	fullName := option.ZipWith(option.O("Douglas"), option.O("Adams"), func(first, last string) string {
+		return first + " " + last
+	})
+	fmt.Println(fullName)
+	// Output: Some(Douglas Adams)
+}
+
+func ExampleUnzip() {
+	name, age := option.Unzip(option.Zip(option.O("Douglas"), option.O(42)))
+	fmt.Println(name, age)
+	name, age = option.Unzip(option.O[option.Pair[string, int]]())
+	fmt.Println(name, age)
+	// Output: Some(Douglas) Some(42)
+	// None None
+}
+
+func ExampleZipResult() {
+	fmt.Println(option.ZipResult(option.Try(strconv.Atoi("1")), option.Try(strconv.ParseBool("true"))))
+	a, b := option.Err[int](errors.New("a")), option.Err[int](errors.New("b"))
+	fmt.Println(option.ZipResult(a, b))
+	fmt.Println(option.ZipResultAll(a, b).String() == "Err(a\nb)")
+	fmt.Println(option.ZipResult3(option.Ok(1), option.Ok("2"), b))
+	fmt.Println(option.UnzipResult(option.ZipResult(option.Ok(1), b)))
+	// Output: Ok({1 true})
+	// Err(a)
+	// true
+	// Err(b)
+	// Err(b) Err(b)
+}
+
+func ExamplePair_MarshalJSON() {
+	raw, _ := json.Marshal(option.Pair[string, int]{First: "age", Second: 42})
+	fmt.Println(string(raw))
+
+	var t option.Triple[int, string, bool]
+	fmt.Println(json.Unmarshal([]byte(`[1, "two", true]`), &t), t)
+	fmt.Println(json.Unmarshal([]byte(`[1, "two"]`), &t))
+	// Output: ["age",42]
+	// <nil> {1 two true}
+	// expected a JSON array of 3 elements, got 2
+}
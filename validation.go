@@ -0,0 +1,192 @@
+package option
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrRequired is used by Required when the Option is None
+var ErrRequired = errors.New("required")
+
+// FieldError is an error attached to a dot separated path, e.g. user.address.zip: required
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors holds every error of a failed Validation,
+// it is compatible with errors.Is and errors.As in the same way as errors.Join.
+// It is always used as a pointer so Results holding it stay comparable
+type ValidationErrors struct {
+	errs []error
+}
+
+func (e *ValidationErrors) Error() string {
+	msgs := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (e *ValidationErrors) Unwrap() []error {
+	return e.errs
+}
+
+// Validation is like a Result but accumulates all errors instead of stopping at the first one,
+// the zero value is valid and holds the zero value of T
+type Validation[T any] struct {
+	v    T
+	errs []error
+}
+
+// Valid constructs a successful Validation
+func Valid[T any](v T) Validation[T] {
+	return Validation[T]{v: v}
+}
+
+// Invalid constructs a failed Validation, nil errors are ignored
+func Invalid[T any](errs ...error) Validation[T] {
+	res := Validation[T]{}
+	for _, err := range errs {
+		if err != nil {
+			res.errs = append(res.errs, err)
+		}
+	}
+	if len(res.errs) == 0 {
+		res.errs = append(res.errs, ErrNotOK)
+	}
+	return res
+}
+
+// Ensure validates v with the provided condition, the Validation fails with err if the condition is false
+func Ensure[T any](v T, cond func(T) bool, err error) Validation[T] {
+	if !cond(v) {
+		return Invalid[T](err)
+	}
+	return Valid(v)
+}
+
+// Required validates that the Option is Some
+func Required[T any](o Option[T]) Validation[T] {
+	if o.IsNone() {
+		return Invalid[T](ErrRequired)
+	}
+	return Valid(*o.some)
+}
+
+// FromResult converts a Result into a Validation
+func FromResult[T any](r Result[T]) Validation[T] {
+	if !r.IsOk() {
+		return Invalid[T](r.err())
+	}
+	return Valid(*r.t)
+}
+
+func (v Validation[T]) IsValid() bool {
+	return len(v.errs) == 0
+}
+
+// Errors returns every error of the Validation
+func (v Validation[T]) Errors() []error {
+	return v.errs
+}
+
+// At prefixes the paths of the errors with path, errors without a path get it as their path
+func (v Validation[T]) At(path string) Validation[T] {
+	if v.IsValid() {
+		return v
+	}
+	errs := make([]error, len(v.errs))
+	for i, err := range v.errs {
+		if fe, ok := err.(*FieldError); ok {
+			errs[i] = &FieldError{Path: path + "." + fe.Path, Err: fe.Err}
+		} else {
+			errs[i] = &FieldError{Path: path, Err: err}
+		}
+	}
+	return Validation[T]{errs: errs}
+}
+
+// Result converts the Validation into a Result, a failed Validation becomes Err with ValidationErrors
+func (v Validation[T]) Result() Result[T] {
+	if !v.IsValid() {
+		return Err[T](&ValidationErrors{errs: v.errs})
+	}
+	return Ok(v.v)
+}
+
+// Combine2 combines two Validations with fn, collecting the errors of both if any fails
+func Combine2[A, B, R any](a Validation[A], b Validation[B], fn func(A, B) R) Validation[R] {
+	if errs := concat(a.errs, b.errs); len(errs) > 0 {
+		return Validation[R]{errs: errs}
+	}
+	return Valid(fn(a.v, b.v))
+}
+
+// Combine3 combines three Validations with fn, collecting the errors of all that fail
+func Combine3[A, B, C, R any](a Validation[A], b Validation[B], c Validation[C], fn func(A, B, C) R) Validation[R] {
+	if errs := concat(a.errs, b.errs, c.errs); len(errs) > 0 {
+		return Validation[R]{errs: errs}
+	}
+	return Valid(fn(a.v, b.v, c.v))
+}
+
+// Combine4 combines four Validations with fn, collecting the errors of all that fail
+func Combine4[A, B, C, D, R any](a Validation[A], b Validation[B], c Validation[C], d Validation[D], fn func(A, B, C, D) R) Validation[R] {
+	if errs := concat(a.errs, b.errs, c.errs, d.errs); len(errs) > 0 {
+		return Validation[R]{errs: errs}
+	}
+	return Valid(fn(a.v, b.v, c.v, d.v))
+}
+
+func concat(errs ...[]error) (res []error) {
+	for _, e := range errs {
+		res = append(res, e...)
+	}
+	return
+}
+
+// Validator collects errors of any number of Validations, it is an alternative to CombineN for larger structs
+//
+//	var v option.Validator
+//	u := User{
+//		Name: option.Check(&v, "name", option.Required(in.Name)),
+//		Age:  option.Check(&v, "age", validateAge(in.Age)),
+//	}
+//	return option.Validated(&v, u)
+type Validator struct {
+	errs []error
+}
+
+// Check records the errors of val under path and returns its value
+func Check[T any](v *Validator, path string, val Validation[T]) T {
+	if path != "" {
+		val = val.At(path)
+	}
+	v.errs = append(v.errs, val.errs...)
+	return val.v
+}
+
+// Add records err under path, nil errors are ignored
+func (v *Validator) Add(path string, err error) {
+	if err != nil {
+		Check(v, path, Invalid[struct{}](err))
+	}
+}
+
+// Validated returns t as a Validation failed with every error collected by v
+func Validated[T any](v *Validator, t T) Validation[T] {
+	if len(v.errs) > 0 {
+		return Validation[T]{errs: v.errs}
+	}
+	return Valid(t)
+}
@@ -0,0 +1,86 @@
+package option_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/debudda/option"
+)
+
+type Address struct {
+	Street string
+	Zip    string
+}
+
+type Customer struct {
+	Name    string
+	Age     int
+	Address Address
+}
+
+var errTooYoung = errors.New("must be at least 18")
+
+func validateAddress(street, zip option.Option[string]) option.Validation[Address] {
+	return option.Combine2(
+		option.Required(street).At("street"),
+		option.Required(zip).At("zip"),
+		func(street, zip string) Address {
+			return Address{Street: street, Zip: zip}
+		})
+}
+
+func validateCustomer(name option.Option[string], age int, street, zip option.Option[string]) option.Validation[Customer] {
+	return option.Combine3(
+		option.Required(name).At("name"),
+		option.Ensure(age, func(age int) bool { return age >= 18 }, errTooYoung).At("age"),
+		validateAddress(street, zip).At("address"),
+		func(name string, age int, address Address) Customer {
+			return Customer{Name: name, Age: age, Address: address}
+		}).At("customer")
+}
+
+func ExampleCombine3() {
+	fmt.Println(validateCustomer(option.O("Douglas"), 42, option.O("Main st"), option.O("12345")).Result())
+	res := validateCustomer(option.O[string](), 12, option.O("Main st"), option.O[string]()).Result()
+	res.Switch(
+		func(c Customer) {},
+		func(err error) {
+			fmt.Println(err)
+			fmt.Println(errors.Is(err, option.ErrRequired), errors.Is(err, errTooYoung))
+			var fe *option.FieldError
+			if errors.As(err, &fe) {
+				fmt.Println("first field:", fe.Path)
+			}
+		})
+	// Output: Ok({Douglas 42 {Main st 12345}})
+	// customer.name: required
+	// customer.age: must be at least 18
+	// customer.address.zip: required
+	// true true
+	// first field: customer.name
+}
+
+func ExampleValidator() {
+	var v option.Validator
+	name := "  "
+	c := Customer{
+		Name: option.Check(&v, "name", option.Ensure(strings.TrimSpace(name), func(s string) bool {
+			return s != ""
+		}, errors.New("must not be blank"))),
+		Age:     option.Check(&v, "age", option.Valid(20)),
+		Address: option.Check(&v, "address", validateAddress(option.O[string](), option.O[string]())),
+	}
+	v.Add("", errors.New("account is locked"))
+	fmt.Println(option.Validated(&v, c).Errors())
+	// Output: [name: must not be blank address.street: required address.zip: required account is locked]
+}
+
+func ExampleValidation_Result() {
+	a := option.Invalid[int](option.ErrRequired).Result()
+	b := option.Invalid[int](option.ErrRequired).Result()
+	fmt.Println(option.EqualResult(a, a), option.EqualResult(a, b))
+	fmt.Println(option.ContainsErr(b, option.ErrRequired))
+	// Output: true false
+	// true
+}